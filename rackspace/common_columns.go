@@ -0,0 +1,121 @@
+package rackspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Resource describes how the standard columns of a resource table are built
+// from its row items
+type Resource struct {
+	// Type of the resource in its akas, such as server
+	Type string
+	// Field of the row item holding the ID of the resource
+	IDField string
+	// Field of the row item holding the display name, defaults to IDField
+	TitleField string
+	// Field of the row item holding the tags, if the resource has any
+	TagsField string
+}
+
+// CommonColumnData holds the connection data of the standard columns
+type CommonColumnData struct {
+	TenantID string
+	Region   string
+	item     interface{}
+}
+
+// commonColumns appends the tenant_id and region columns of the connection
+// to the columns of a table, unless the table already defines them.
+func commonColumns(columns []*plugin.Column) []*plugin.Column {
+	return appendMissingColumns(columns, []*plugin.Column{
+		{Name: "tenant_id", Type: proto.ColumnType_STRING, Description: "The ID of the tenant of the connection.", Hydrate: getCommonColumns, Transform: transform.FromField("TenantID")},
+		{Name: "region", Type: proto.ColumnType_STRING, Description: "The region of the connection.", Hydrate: getCommonColumns, Transform: transform.FromField("Region")},
+	})
+}
+
+// resourceColumns appends the common columns along with the title, akas and
+// tags columns of the resource to the columns of a table, unless the table
+// already defines them.
+func resourceColumns(resource Resource, columns []*plugin.Column) []*plugin.Column {
+	standard := []*plugin.Column{
+		{Name: "title", Type: proto.ColumnType_STRING, Description: "The display name of the resource, or its ID if it has none.", Transform: transform.FromP(resourceTitle, resource)},
+		{Name: "akas", Type: proto.ColumnType_JSON, Description: "The unique identifiers of the resource, such as rackspace:{region}:{tenant}:server/{id}.", Hydrate: getCommonColumns, Transform: transform.FromValue().TransformP(resourceAkas, resource)},
+	}
+	if resource.TagsField != "" {
+		standard = append(standard, &plugin.Column{Name: "tags", Type: proto.ColumnType_JSON, Description: "The tags of the resource.", Transform: transform.FromField(resource.TagsField)})
+	}
+
+	return commonColumns(appendMissingColumns(columns, standard))
+}
+
+// appendMissingColumns appends the columns whose name is not yet used by the table
+func appendMissingColumns(columns []*plugin.Column, extra []*plugin.Column) []*plugin.Column {
+	names := map[string]bool{}
+	for _, column := range columns {
+		names[column.Name] = true
+	}
+	for _, column := range extra {
+		if !names[column.Name] {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// getCommonColumns returns the tenant and region of the connection for the row
+func getCommonColumns(_ context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &CommonColumnData{item: h.Item}
+	if rackspaceConfig.TenantID != nil {
+		data.TenantID = *rackspaceConfig.TenantID
+	}
+	if rackspaceConfig.Region != nil {
+		data.Region = *rackspaceConfig.Region
+	}
+	return data, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// resourceAkas builds the URN of the resource of the row
+func resourceAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.Value.(*CommonColumnData)
+	resource := d.Param.(Resource)
+
+	id, ok := helpers.GetNestedFieldValueFromInterface(data.item, resource.IDField)
+	if !ok || helpers.IsNil(id) || fmt.Sprint(id) == "" {
+		return nil, nil
+	}
+
+	return []string{fmt.Sprintf("rackspace:%s:%s:%s/%v", data.Region, data.TenantID, resource.Type, id)}, nil
+}
+
+// resourceTitle returns the display name of the resource of the row, falling
+// back to its ID for unnamed resources
+func resourceTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resource := d.Param.(Resource)
+
+	if resource.TitleField != "" {
+		title, ok := helpers.GetNestedFieldValueFromInterface(d.HydrateItem, resource.TitleField)
+		if ok && !helpers.IsNil(title) && fmt.Sprint(title) != "" {
+			return title, nil
+		}
+	}
+
+	id, ok := helpers.GetNestedFieldValueFromInterface(d.HydrateItem, resource.IDField)
+	if !ok || helpers.IsNil(id) || fmt.Sprint(id) == "" {
+		return nil, nil
+	}
+	return id, nil
+}
@@ -1,15 +1,24 @@
 package rackspace
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/schema"
 )
 
 type rackspaceConfig struct {
-	IdentityEndpoint *string `cty:"identity_endpoint"`
-	TenantID         *string `cty:"tenant_id"`
-	TokenID          *string `cty:"token_id"`
-	Region           *string `cty:"region"`
+	IdentityEndpoint      *string `cty:"identity_endpoint"`
+	TenantID              *string `cty:"tenant_id"`
+	TokenID               *string `cty:"token_id"`
+	Username              *string `cty:"username"`
+	APIKey                *string `cty:"api_key"`
+	Password              *string `cty:"password"`
+	Region                *string `cty:"region"`
+	PriceCatalog          *string `cty:"price_catalog"`
+	IgnoreForbiddenErrors *bool   `cty:"ignore_forbidden_errors"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -22,9 +31,24 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"token_id": {
 		Type: schema.TypeString,
 	},
+	"username": {
+		Type: schema.TypeString,
+	},
+	"api_key": {
+		Type: schema.TypeString,
+	},
+	"password": {
+		Type: schema.TypeString,
+	},
 	"region": {
 		Type: schema.TypeString,
 	},
+	"price_catalog": {
+		Type: schema.TypeString,
+	},
+	"ignore_forbidden_errors": {
+		Type: schema.TypeBool,
+	},
 }
 
 func ConfigInstance() interface{} {
@@ -39,3 +63,83 @@ func GetConfig(connection *plugin.Connection) rackspaceConfig {
 	config, _ := connection.Config.(rackspaceConfig)
 	return config
 }
+
+// rackspaceRegions lists the region codes of the Rackspace public cloud
+var rackspaceRegions = []string{"DFW", "ORD", "IAD", "LON", "SYD", "HKG"}
+
+// configValidation holds the cached validation result of a connection config
+type configValidation struct {
+	err error
+}
+
+// getValidatedConfig retrieves the connection config and validates it once per connection.
+// Once it returns a nil error, every required attribute is guaranteed to be set.
+func getValidatedConfig(d *plugin.QueryData) (rackspaceConfig, error) {
+	config := GetConfig(d.Connection)
+
+	cacheKey := "rackspace-config-validation"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return normalizeConfig(config), cachedData.(configValidation).err
+	}
+
+	err := validateConfig(config)
+	d.ConnectionManager.Cache.Set(cacheKey, configValidation{err: err})
+	return normalizeConfig(config), err
+}
+
+// validateConfig checks every attribute of the connection config and reports all the problems at once
+func validateConfig(config rackspaceConfig) error {
+	var problems []string
+
+	if config.IdentityEndpoint == nil || *config.IdentityEndpoint == "" {
+		problems = append(problems, `'identity_endpoint' must be set, e.g. "https://identity.api.rackspacecloud.com/v2.0/"`)
+	} else if endpoint, err := url.Parse(*config.IdentityEndpoint); err != nil || (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+		problems = append(problems, fmt.Sprintf(`'identity_endpoint' %q is not an absolute http(s) URL, e.g. "https://identity.api.rackspacecloud.com/v2.0/"`, *config.IdentityEndpoint))
+	}
+
+	if config.TenantID == nil || *config.TenantID == "" {
+		problems = append(problems, "'tenant_id' must be set")
+	}
+
+	hasUsername := config.Username != nil && *config.Username != ""
+	hasSecret := (config.APIKey != nil && *config.APIKey != "") || (config.Password != nil && *config.Password != "")
+	hasToken := config.TokenID != nil && *config.TokenID != ""
+	switch {
+	case hasUsername && !hasSecret:
+		problems = append(problems, "'api_key' or 'password' must be set along with 'username'")
+	case hasSecret && !hasUsername:
+		problems = append(problems, "'username' must be set along with 'api_key' or 'password'")
+	case !hasSecret && !hasToken:
+		problems = append(problems, "'username' with 'api_key' or 'password' must be set, or 'token_id'")
+	}
+
+	if config.Region == nil || *config.Region == "" {
+		problems = append(problems, fmt.Sprintf("'region' must be set to one of %s", strings.Join(rackspaceRegions, ", ")))
+	} else if !isRackspaceRegion(*config.Region) {
+		problems = append(problems, fmt.Sprintf("'region' %q is not a known region, use one of %s", *config.Region, strings.Join(rackspaceRegions, ", ")))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid connection configuration: %s. Edit your connection configuration file and then restart Steampipe", strings.Join(problems, "; "))
+}
+
+// normalizeConfig upper-cases the region, as the service catalog lists regions in upper case
+func normalizeConfig(config rackspaceConfig) rackspaceConfig {
+	if config.Region != nil {
+		region := strings.ToUpper(*config.Region)
+		config.Region = &region
+	}
+	return config
+}
+
+// isRackspaceRegion reports whether region is a known region code, ignoring case
+func isRackspaceRegion(region string) bool {
+	for _, r := range rackspaceRegions {
+		if strings.EqualFold(r, region) {
+			return true
+		}
+	}
+	return false
+}
@@ -15,23 +15,58 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			Schema:      ConfigSchema,
 		},
 		DefaultTransform: transform.FromGo().NullIfZero(),
+		DefaultIgnoreConfig: &plugin.IgnoreConfig{
+			ShouldIgnoreErrorFunc: shouldIgnoreErrors,
+		},
 		TableMap: map[string]*plugin.Table{
-			"rackspace_compute_server":         tableRackspaceComputeServer(),
-			"rackspace_compute_keypair":        tableRackspaceComputeKeyPair(),
-			"rackspace_compute_flavor":         tableRackspaceComputeFlavor(),
-			"rackspace_compute_limit":          tableRackspaceComputeLimit(),
-			"rackspace_image":                  tableRackspaceImage(),
-			"rackspace_snapshot":               tableRackspaceSnapshot(),
-			"rackspace_volume":                 tableRackspaceVolume(),
-			"rackspace_cloud_files_container":  tableRackspaceCloudFilesContainer(),
-			"rackspace_cloud_files_object":     tableRackspaceCloudFilesObject(),
-			"rackspace_message_queue":          tableRackspaceMessageQueue(),
-			"rackspace_loadbalancer":           tableRackspaceLoadBalancer(),
-			"rackspace_dns_domain":             tableRackspaceDNSDomain(),
-			"rackspace_network":                tableRackspaceNetwork(),
-			"rackspace_network_port":           tableRackspaceNetworkPort(),
-			"rackspace_network_subnet":         tableRackspaceNetworkSubnet(),
-			"rackspace_network_security_group": tableRackspaceNetworkSecurityGroup(),
+			"rackspace_compute_server":                   tableRackspaceComputeServer(),
+			"rackspace_compute_server_address":           tableRackspaceComputeServerAddress(),
+			"rackspace_compute_server_virtual_interface": tableRackspaceComputeServerVirtualInterface(),
+			"rackspace_compute_server_metadata":          tableRackspaceComputeServerMetadata(),
+			"rackspace_compute_server_volume_attachment": tableRackspaceComputeServerVolumeAttachment(),
+			"rackspace_compute_server_diagnostic":        tableRackspaceComputeServerDiagnostic(),
+			"rackspace_compute_server_image_schedule":    tableRackspaceComputeServerImageSchedule(),
+			"rackspace_compute_keypair":                  tableRackspaceComputeKeyPair(),
+			"rackspace_compute_flavor":                   tableRackspaceComputeFlavor(),
+			"rackspace_compute_flavor_access":            tableRackspaceComputeFlavorAccess(),
+			"rackspace_compute_limit":                    tableRackspaceComputeLimit(),
+			"rackspace_compute_rate_limit":               tableRackspaceComputeRateLimit(),
+			"rackspace_image":                            tableRackspaceImage(),
+			"rackspace_image_member":                     tableRackspaceImageMember(),
+			"rackspace_snapshot":                         tableRackspaceSnapshot(),
+			"rackspace_volume":                           tableRackspaceVolume(),
+			"rackspace_volume_type":                      tableRackspaceVolumeType(),
+			"rackspace_volume_attachment":                tableRackspaceVolumeAttachment(),
+			"rackspace_volume_quota":                     tableRackspaceVolumeQuota(),
+			"rackspace_cloud_files_container":            tableRackspaceCloudFilesContainer(),
+			"rackspace_cloud_files_object":               tableRackspaceCloudFilesObject(),
+			"rackspace_cloud_files_account":              tableRackspaceCloudFilesAccount(),
+			"rackspace_message_queue":                    tableRackspaceMessageQueue(),
+			"rackspace_loadbalancer":                     tableRackspaceLoadBalancer(),
+			"rackspace_loadbalancer_limit":               tableRackspaceLoadBalancerLimit(),
+			"rackspace_dns_domain":                       tableRackspaceDNSDomain(),
+			"rackspace_dns_limit":                        tableRackspaceDNSLimit(),
+			"rackspace_network":                          tableRackspaceNetwork(),
+			"rackspace_network_port":                     tableRackspaceNetworkPort(),
+			"rackspace_network_subnet":                   tableRackspaceNetworkSubnet(),
+			"rackspace_network_security_group":           tableRackspaceNetworkSecurityGroup(),
+			"rackspace_network_security_group_rule":      tableRackspaceNetworkSecurityGroupRule(),
+			"rackspace_backup_agent":                     tableRackspaceBackupAgent(),
+			"rackspace_backup_configuration":             tableRackspaceBackupConfiguration(),
+			"rackspace_backup_activity":                  tableRackspaceBackupActivity(),
+			"rackspace_backup_restore":                   tableRackspaceBackupRestore(),
+			"rackspace_monitoring_metric":                tableRackspaceMonitoringMetric(),
+			"rackspace_monitoring_agent":                 tableRackspaceMonitoringAgent(),
+			"rackspace_monitoring_agent_host_info":       tableRackspaceMonitoringAgentHostInfo(),
+			"rackspace_billing_account":                  tableRackspaceBillingAccount(),
+			"rackspace_billing_invoice":                  tableRackspaceBillingInvoice(),
+			"rackspace_billing_estimated_charge":         tableRackspaceBillingEstimatedCharge(),
+			"rackspace_service":                          tableRackspaceService(),
+			"rackspace_quota_usage":                      tableRackspaceQuotaUsage(),
+			"rackspace_identity_user":                    tableRackspaceIdentityUser(),
+			"rackspace_identity_user_role":               tableRackspaceIdentityUserRole(),
+			"rackspace_identity_endpoint":                tableRackspaceIdentityEndpoint(),
+			"rackspace_identity_token":                   tableRackspaceIdentityToken(),
 		},
 	}
 	return p
@@ -0,0 +1,90 @@
+package rackspace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// bytesPerGB is the number of bytes in a gigabyte as billed by Cloud Files
+const bytesPerGB = 1024 * 1024 * 1024
+
+// PriceCatalog holds the monthly prices used to estimate costs. It is read
+// from the YAML or JSON file configured as price_catalog, for example:
+//
+//	flavors:
+//	  general1-1: 29.20
+//	volume_types:
+//	  SSD: 0.45
+//	load_balancer: 10.95
+//	files_gb_stored: 0.10
+type PriceCatalog struct {
+	// Monthly price of a server, keyed by flavor ID
+	Flavors map[string]float64 `json:"flavors"`
+	// Monthly price per GB of a volume, keyed by volume type
+	VolumeTypes map[string]float64 `json:"volume_types"`
+	// Monthly price of a load balancer
+	LoadBalancer *float64 `json:"load_balancer"`
+	// Monthly price per GB stored in Cloud Files
+	FilesGBStored *float64 `json:"files_gb_stored"`
+}
+
+var getPriceCatalogMemoized = plugin.HydrateFunc(getPriceCatalog).Memoize()
+
+// getPriceCatalog loads the price catalog of the connection, or returns nil
+// when none is configured.
+func getPriceCatalog(_ context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	if rackspaceConfig.PriceCatalog == nil || *rackspaceConfig.PriceCatalog == "" {
+		return (*PriceCatalog)(nil), nil
+	}
+
+	// Expand the home directory, as in the example configuration
+	path := *rackspaceConfig.PriceCatalog
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price catalog: %w", err)
+	}
+
+	var catalog PriceCatalog
+	if err := yaml.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse price catalog %s: %w", path, err)
+	}
+
+	return &catalog, nil
+}
+
+// estimateMonthlyCost loads the price catalog and applies estimate to it. It
+// returns nil when no catalog is configured or the catalog has no price.
+func estimateMonthlyCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, estimate func(catalog *PriceCatalog) *float64) (interface{}, error) {
+	catalog, err := getPriceCatalogMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	if catalog.(*PriceCatalog) == nil {
+		return nil, nil
+	}
+
+	cost := estimate(catalog.(*PriceCatalog))
+	if cost == nil {
+		return nil, nil
+	}
+	return *cost, nil
+}
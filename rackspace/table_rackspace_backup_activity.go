@@ -0,0 +1,72 @@
+package rackspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// BackupActivity represents a backup, restore or cleanup run of a Cloud Backup agent
+type BackupActivity struct {
+	ID                     int64  `json:"ID"`
+	Type                   string `json:"Type"`
+	ParentID               int64  `json:"ParentId"`
+	DisplayName            string `json:"DisplayName"`
+	SourceMachineAgentID   int64  `json:"SourceMachineAgentId"`
+	SourceMachineName      string `json:"SourceMachineName"`
+	DestinationMachineName string `json:"DestinationMachineName"`
+	CurrentState           string `json:"CurrentState"`
+	TimeOfActivity         string `json:"TimeOfActivity"`
+}
+
+func tableRackspaceBackupActivity() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_backup_activity",
+		Description: "Retrieve the backup, restore and cleanup activity of each Rackspace Cloud Backup agent.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listBackupAgents,
+			Hydrate:       listBackupActivities,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_INT, Description: "The ID of the backup, restore or cleanup the activity refers to.", Transform: transform.FromField("ID")},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the activity, such as Backup, Restore or Cleanup."},
+			{Name: "parent_id", Type: proto.ColumnType_INT, Description: "The ID of the backup configuration or backup the activity was started from.", Transform: transform.FromField("ParentID")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the activity."},
+			{Name: "machine_agent_id", Type: proto.ColumnType_INT, Description: "The ID of the backup agent the activity ran on.", Transform: transform.FromField("SourceMachineAgentID")},
+			{Name: "source_machine_name", Type: proto.ColumnType_STRING, Description: "The name of the machine the activity ran on."},
+			{Name: "destination_machine_name", Type: proto.ColumnType_STRING, Description: "The name of the machine restored to, for restores."},
+			{Name: "current_state", Type: proto.ColumnType_STRING, Description: "The state of the activity, such as Completed, CompletedWithErrors, Failed, Missed or Skipped."},
+			{Name: "time_of_activity", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the activity.", Transform: transform.FromField("TimeOfActivity").Transform(backupDateToTimestamp)},
+		}),
+	}
+}
+
+// listBackupActivities streams the activity of each agent
+func listBackupActivities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	agent := h.Item.(BackupAgent)
+
+	activities, err := getBackupAgentActivities(ctx, d, agent.MachineAgentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream each activity to the table
+	for _, activity := range activities {
+		d.StreamListItem(ctx, activity)
+	}
+
+	return nil, nil
+}
+
+// getBackupAgentActivities returns the activity of a backup agent
+func getBackupAgentActivities(ctx context.Context, d *plugin.QueryData, machineAgentID int64) ([]BackupActivity, error) {
+	var activities []BackupActivity
+	path := fmt.Sprintf("system/activity/%d", machineAgentID)
+	if err := getBackupResource(ctx, d, path, &activities); err != nil {
+		return nil, fmt.Errorf("failed to retrieve backup activity for agent %d: %w", machineAgentID, err)
+	}
+	return activities, nil
+}
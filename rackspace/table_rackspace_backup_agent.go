@@ -0,0 +1,148 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// BackupAgent represents a Rackspace Cloud Backup agent installed on a server
+type BackupAgent struct {
+	MachineAgentID             int64   `json:"MachineAgentId"`
+	MachineName                string  `json:"MachineName"`
+	HostServerID               string  `json:"HostServerId"`
+	AgentVersion               string  `json:"AgentVersion"`
+	Architecture               string  `json:"Architecture"`
+	Flavor                     string  `json:"Flavor"`
+	Datacenter                 string  `json:"Datacenter"`
+	IPAddress                  string  `json:"IPAddress"`
+	OperatingSystem            string  `json:"OperatingSystem"`
+	OperatingSystemVersion     string  `json:"OperatingSystemVersion"`
+	Status                     string  `json:"Status"`
+	IsDisabled                 bool    `json:"IsDisabled"`
+	IsEncrypted                bool    `json:"IsEncrypted"`
+	UseServiceNet              bool    `json:"UseServiceNet"`
+	CleanupAllowed             bool    `json:"CleanupAllowed"`
+	BackupVaultSize            string  `json:"BackupVaultSize"`
+	TimeOfLastSuccessfulBackup *string `json:"TimeOfLastSuccessfulBackup"`
+}
+
+func tableRackspaceBackupAgent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_backup_agent",
+		Description: "Retrieve the Rackspace Cloud Backup agents of the tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listBackupAgents,
+		},
+		Columns: resourceColumns(Resource{Type: "backup-agent", IDField: "MachineAgentID", TitleField: "MachineName"}, []*plugin.Column{
+			{Name: "machine_agent_id", Type: proto.ColumnType_INT, Description: "The unique identifier of the backup agent.", Transform: transform.FromField("MachineAgentID")},
+			{Name: "machine_name", Type: proto.ColumnType_STRING, Description: "The name of the machine the agent runs on."},
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the compute server the agent runs on.", Transform: transform.FromField("HostServerID")},
+			{Name: "agent_version", Type: proto.ColumnType_STRING, Description: "The version of the backup agent."},
+			{Name: "architecture", Type: proto.ColumnType_STRING, Description: "The architecture of the machine, such as 64-bit."},
+			{Name: "flavor", Type: proto.ColumnType_STRING, Description: "The flavor of the machine, such as RaxCloudServer."},
+			{Name: "datacenter", Type: proto.ColumnType_STRING, Description: "The datacenter the backups are stored in."},
+			{Name: "ip_address", Type: proto.ColumnType_INET, Description: "The IP address of the machine.", Transform: transform.FromField("IPAddress")},
+			{Name: "operating_system", Type: proto.ColumnType_STRING, Description: "The operating system of the machine."},
+			{Name: "operating_system_version", Type: proto.ColumnType_STRING, Description: "The version of the operating system of the machine."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the agent, such as Online or Offline."},
+			{Name: "is_disabled", Type: proto.ColumnType_BOOL, Description: "Whether the agent is disabled.", Transform: transform.FromField("IsDisabled")},
+			{Name: "is_encrypted", Type: proto.ColumnType_BOOL, Description: "Whether the backups of the agent are encrypted.", Transform: transform.FromField("IsEncrypted")},
+			{Name: "use_service_net", Type: proto.ColumnType_BOOL, Description: "Whether the agent sends backups over ServiceNet.", Transform: transform.FromField("UseServiceNet")},
+			{Name: "cleanup_allowed", Type: proto.ColumnType_BOOL, Description: "Whether cleanups of the backup vault are allowed.", Transform: transform.FromField("CleanupAllowed")},
+			{Name: "backup_vault_size", Type: proto.ColumnType_STRING, Description: "The size of the backup vault, such as 1.2 GB."},
+			{Name: "time_of_last_successful_backup", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the last successful backup of the agent.", Transform: transform.FromField("TimeOfLastSuccessfulBackup").Transform(backupDateToTimestamp)},
+		}),
+	}
+}
+
+func listBackupAgents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	var agents []BackupAgent
+	if err := getBackupResource(ctx, d, "user/agents", &agents); err != nil {
+		return nil, fmt.Errorf("failed to retrieve backup agents: %w", err)
+	}
+
+	// Stream each agent to the table
+	for _, agent := range agents {
+		d.StreamListItem(ctx, agent)
+	}
+
+	return nil, nil
+}
+
+// getBackupResource performs an authenticated GET request against the Cloud
+// Backup API of the tenant and decodes the JSON response into result.
+func getBackupResource(ctx context.Context, d *plugin.QueryData, path string, result interface{}) error {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://%s.backup.api.rackspacecloud.com/v1.0/%s/%s",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+		path,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp, "unexpected status")
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+//// TRANSFORM FUNCTIONS
+
+// backupDatePattern matches the /Date(milliseconds)/ format of the Cloud Backup API
+var backupDatePattern = regexp.MustCompile(`^/Date\((-?\d+)([+-]\d{4})?\)/$`)
+
+// backupDateToTimestamp converts a Cloud Backup /Date(milliseconds)/ value to a time
+func backupDateToTimestamp(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var value string
+	switch v := d.Value.(type) {
+	case string:
+		value = v
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		value = *v
+	default:
+		return nil, nil
+	}
+
+	match := backupDatePattern.FindStringSubmatch(value)
+	if match == nil {
+		return nil, nil
+	}
+	milliseconds, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return time.UnixMilli(milliseconds).UTC(), nil
+}
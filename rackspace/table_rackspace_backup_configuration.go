@@ -0,0 +1,100 @@
+package rackspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// BackupConfiguration represents a Rackspace Cloud Backup configuration, i.e.
+// what an agent backs up and on which schedule
+type BackupConfiguration struct {
+	BackupConfigurationID   int64                 `json:"BackupConfigurationId"`
+	BackupConfigurationName string                `json:"BackupConfigurationName"`
+	MachineAgentID          int64                 `json:"MachineAgentId"`
+	MachineName             string                `json:"MachineName"`
+	Datacenter              string                `json:"Datacenter"`
+	IsActive                bool                  `json:"IsActive"`
+	IsDeleted               bool                  `json:"IsDeleted"`
+	IsEncrypted             bool                  `json:"IsEncrypted"`
+	VersionRetention        int                   `json:"VersionRetention"`
+	Frequency               string                `json:"Frequency"`
+	StartTimeHour           *int                  `json:"StartTimeHour"`
+	StartTimeMinute         *int                  `json:"StartTimeMinute"`
+	StartTimeAmPm           *string               `json:"StartTimeAmPm"`
+	DayOfWeekID             *int                  `json:"DayOfWeekId"`
+	HourInterval            *int                  `json:"HourInterval"`
+	TimeZoneID              string                `json:"TimeZoneId"`
+	NextScheduledRunTime    *string               `json:"NextScheduledRunTime"`
+	LastRunTime             *string               `json:"LastRunTime"`
+	LastRunBackupReportID   *int64                `json:"LastRunBackupReportId"`
+	NotifyRecipients        string                `json:"NotifyRecipients"`
+	NotifySuccess           bool                  `json:"NotifySuccess"`
+	NotifyFailure           bool                  `json:"NotifyFailure"`
+	Inclusions              []BackupFileSelection `json:"Inclusions"`
+	Exclusions              []BackupFileSelection `json:"Exclusions"`
+}
+
+// BackupFileSelection represents a file or folder included in or excluded from a backup
+type BackupFileSelection struct {
+	FilePath     string `json:"FilePath"`
+	FileItemType string `json:"FileItemType"`
+}
+
+func tableRackspaceBackupConfiguration() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_backup_configuration",
+		Description: "Retrieve the Rackspace Cloud Backup configurations of each backup agent.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listBackupAgents,
+			Hydrate:       listBackupConfigurations,
+		},
+		Columns: resourceColumns(Resource{Type: "backup-configuration", IDField: "BackupConfigurationID", TitleField: "BackupConfigurationName"}, []*plugin.Column{
+			{Name: "backup_configuration_id", Type: proto.ColumnType_INT, Description: "The unique identifier of the backup configuration.", Transform: transform.FromField("BackupConfigurationID")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the backup configuration.", Transform: transform.FromField("BackupConfigurationName")},
+			{Name: "machine_agent_id", Type: proto.ColumnType_INT, Description: "The ID of the backup agent the configuration belongs to.", Transform: transform.FromField("MachineAgentID")},
+			{Name: "machine_name", Type: proto.ColumnType_STRING, Description: "The name of the machine the configuration backs up."},
+			{Name: "datacenter", Type: proto.ColumnType_STRING, Description: "The datacenter the backups are stored in."},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "Whether the backup configuration is active.", Transform: transform.FromField("IsActive")},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Whether the backup configuration is deleted.", Transform: transform.FromField("IsDeleted")},
+			{Name: "is_encrypted", Type: proto.ColumnType_BOOL, Description: "Whether the backups are encrypted.", Transform: transform.FromField("IsEncrypted")},
+			{Name: "version_retention", Type: proto.ColumnType_INT, Description: "The number of days backup versions are retained, 0 for indefinitely.", Transform: transform.FromField("VersionRetention")},
+			{Name: "frequency", Type: proto.ColumnType_STRING, Description: "The frequency of the schedule, such as Manually, Hourly, Daily or Weekly."},
+			{Name: "start_time_hour", Type: proto.ColumnType_INT, Description: "The hour the scheduled backup starts at.", Transform: transform.FromField("StartTimeHour")},
+			{Name: "start_time_minute", Type: proto.ColumnType_INT, Description: "The minute the scheduled backup starts at.", Transform: transform.FromField("StartTimeMinute")},
+			{Name: "start_time_am_pm", Type: proto.ColumnType_STRING, Description: "Whether the start time is AM or PM."},
+			{Name: "day_of_week_id", Type: proto.ColumnType_INT, Description: "The day of the week weekly backups run on, 0 for Sunday.", Transform: transform.FromField("DayOfWeekID")},
+			{Name: "hour_interval", Type: proto.ColumnType_INT, Description: "The number of hours between hourly backups.", Transform: transform.FromField("HourInterval")},
+			{Name: "time_zone_id", Type: proto.ColumnType_STRING, Description: "The time zone of the schedule.", Transform: transform.FromField("TimeZoneID")},
+			{Name: "next_scheduled_run_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the next scheduled backup.", Transform: transform.FromField("NextScheduledRunTime").Transform(backupDateToTimestamp)},
+			{Name: "last_run_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the last backup run.", Transform: transform.FromField("LastRunTime").Transform(backupDateToTimestamp)},
+			{Name: "last_run_backup_report_id", Type: proto.ColumnType_INT, Description: "The ID of the report of the last backup run.", Transform: transform.FromField("LastRunBackupReportID")},
+			{Name: "notify_recipients", Type: proto.ColumnType_STRING, Description: "The email addresses notified about backups."},
+			{Name: "notify_success", Type: proto.ColumnType_BOOL, Description: "Whether recipients are notified of successful backups.", Transform: transform.FromField("NotifySuccess")},
+			{Name: "notify_failure", Type: proto.ColumnType_BOOL, Description: "Whether recipients are notified of failed backups.", Transform: transform.FromField("NotifyFailure")},
+			{Name: "inclusions", Type: proto.ColumnType_JSON, Description: "The files and folders included in the backup."},
+			{Name: "exclusions", Type: proto.ColumnType_JSON, Description: "The files and folders excluded from the backup."},
+		}),
+	}
+}
+
+// listBackupConfigurations streams the backup configurations of each agent
+func listBackupConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	agent := h.Item.(BackupAgent)
+
+	var configurations []BackupConfiguration
+	path := fmt.Sprintf("backup-configuration/system/%d", agent.MachineAgentID)
+	if err := getBackupResource(ctx, d, path, &configurations); err != nil {
+		return nil, fmt.Errorf("failed to retrieve backup configurations for agent %d: %w", agent.MachineAgentID, err)
+	}
+
+	// Stream each configuration to the table
+	for _, configuration := range configurations {
+		d.StreamListItem(ctx, configuration)
+	}
+
+	return nil, nil
+}
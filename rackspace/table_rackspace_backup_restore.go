@@ -0,0 +1,78 @@
+package rackspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// BackupRestore represents a restore of a Cloud Backup to a machine
+type BackupRestore struct {
+	RestoreID                  int64   `json:"RestoreId"`
+	BackupID                   int64   `json:"BackupId"`
+	BackupConfigurationID      int64   `json:"BackupConfigurationId"`
+	BackupConfigurationName    string  `json:"BackupConfigurationName"`
+	MachineAgentID             int64   `json:"MachineAgentId"`
+	MachineName                string  `json:"MachineName"`
+	DestinationMachineAgentID  int64   `json:"DestinationMachineAgentId"`
+	DestinationMachineName     string  `json:"DestinationMachineName"`
+	DestinationPath            string  `json:"DestinationPath"`
+	OverwriteFiles             bool    `json:"OverwriteFiles"`
+	Status                     string  `json:"Status"`
+	BackupRestorePoint         *string `json:"BackupRestorePoint"`
+	RestoreStateChangeDateTime *string `json:"RestoreStateChangeDateTime"`
+}
+
+func tableRackspaceBackupRestore() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_backup_restore",
+		Description: "Retrieve the restores run by each Rackspace Cloud Backup agent.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listBackupAgents,
+			Hydrate:       listBackupRestores,
+		},
+		Columns: resourceColumns(Resource{Type: "backup-restore", IDField: "RestoreID"}, []*plugin.Column{
+			{Name: "restore_id", Type: proto.ColumnType_INT, Description: "The unique identifier of the restore.", Transform: transform.FromField("RestoreID")},
+			{Name: "backup_id", Type: proto.ColumnType_INT, Description: "The ID of the backup restored from.", Transform: transform.FromField("BackupID")},
+			{Name: "backup_configuration_id", Type: proto.ColumnType_INT, Description: "The ID of the backup configuration of the restored backup.", Transform: transform.FromField("BackupConfigurationID")},
+			{Name: "backup_configuration_name", Type: proto.ColumnType_STRING, Description: "The name of the backup configuration of the restored backup."},
+			{Name: "machine_agent_id", Type: proto.ColumnType_INT, Description: "The ID of the backup agent the backup was taken on.", Transform: transform.FromField("MachineAgentID")},
+			{Name: "machine_name", Type: proto.ColumnType_STRING, Description: "The name of the machine the backup was taken on."},
+			{Name: "destination_machine_agent_id", Type: proto.ColumnType_INT, Description: "The ID of the backup agent restored to.", Transform: transform.FromField("DestinationMachineAgentID")},
+			{Name: "destination_machine_name", Type: proto.ColumnType_STRING, Description: "The name of the machine restored to."},
+			{Name: "destination_path", Type: proto.ColumnType_STRING, Description: "The path files are restored to."},
+			{Name: "overwrite_files", Type: proto.ColumnType_BOOL, Description: "Whether existing files are overwritten.", Transform: transform.FromField("OverwriteFiles")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the restore, such as Queued, InProgress, Completed or Failed."},
+			{Name: "backup_restore_point", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the backup restored from.", Transform: transform.FromField("BackupRestorePoint").Transform(backupDateToTimestamp)},
+			{Name: "restore_state_change_time", Type: proto.ColumnType_TIMESTAMP, Description: "The time the status of the restore last changed.", Transform: transform.FromField("RestoreStateChangeDateTime").Transform(backupDateToTimestamp)},
+		}),
+	}
+}
+
+// listBackupRestores streams the restores found in the activity of each agent
+func listBackupRestores(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	agent := h.Item.(BackupAgent)
+
+	activities, err := getBackupAgentActivities(ctx, d, agent.MachineAgentID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, activity := range activities {
+		if activity.Type != "Restore" {
+			continue
+		}
+
+		// Retrieve the details of the restore
+		var restore BackupRestore
+		if err := getBackupResource(ctx, d, fmt.Sprintf("restore/%d", activity.ID), &restore); err != nil {
+			return nil, fmt.Errorf("failed to retrieve backup restore %d: %w", activity.ID, err)
+		}
+		d.StreamListItem(ctx, restore)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,128 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// cloudAccountNumberPrefix prefixes the tenant ID in the Rackspace account
+// number (RAN) of Cloud accounts
+const cloudAccountNumberPrefix = "020-"
+
+// BillingAccount represents the billing account of the tenant
+type BillingAccount struct {
+	RAN           string `json:"ran"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	Currency      string `json:"currency"`
+	PaymentTerms  string `json:"paymentTerms"`
+	PaymentMethod string `json:"paymentMethod"`
+	BillingDay    *int   `json:"billingDay"`
+}
+
+// BillingBalance represents the current balance of a billing account
+type BillingBalance struct {
+	Amount          json.Number `json:"amount"`
+	Currency        string      `json:"currency"`
+	LastInvoiceDate string      `json:"lastInvoiceDate"`
+}
+
+func tableRackspaceBillingAccount() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_billing_account",
+		Description: "The Rackspace billing account of the tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listBillingAccounts,
+		},
+		Columns: resourceColumns(Resource{Type: "billing-account", IDField: "RAN", TitleField: "Name"}, []*plugin.Column{
+			{Name: "ran", Type: proto.ColumnType_STRING, Description: "The Rackspace account number (RAN) of the billing account.", Transform: transform.FromField("RAN")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the billing account."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the billing account."},
+			{Name: "currency", Type: proto.ColumnType_STRING, Description: "The currency the account is billed in."},
+			{Name: "payment_terms", Type: proto.ColumnType_STRING, Description: "The payment terms of the account."},
+			{Name: "payment_method", Type: proto.ColumnType_STRING, Description: "The payment method of the account."},
+			{Name: "billing_day", Type: proto.ColumnType_INT, Description: "The day of the month the account is invoiced on.", Transform: transform.FromField("BillingDay")},
+			{Name: "balance", Type: proto.ColumnType_DOUBLE, Description: "The current balance of the account.", Hydrate: getBillingBalance, Transform: transform.FromField("Amount").Transform(billingAmount)},
+			{Name: "balance_currency", Type: proto.ColumnType_STRING, Description: "The currency of the current balance.", Hydrate: getBillingBalance, Transform: transform.FromField("Currency")},
+			{Name: "last_invoice_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date of the last invoice.", Hydrate: getBillingBalance, Transform: transform.FromField("LastInvoiceDate").NullIfZero()},
+		}),
+	}
+}
+
+func listBillingAccounts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var result struct {
+		BillingAccount BillingAccount `json:"billingAccount"`
+	}
+	if err := getBillingResource(ctx, d, h, "", &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve billing account: %w", err)
+	}
+
+	// Stream the account as a single row
+	d.StreamListItem(ctx, result.BillingAccount)
+	return nil, nil
+}
+
+func getBillingBalance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var result struct {
+		Balance BillingBalance `json:"balance"`
+	}
+	if err := getBillingResource(ctx, d, h, "/balance", &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve billing balance: %w", err)
+	}
+	return result.Balance, nil
+}
+
+// getBillingResource performs an authenticated GET request against the
+// Billing v2 API of the account and decodes the JSON response into result.
+func getBillingResource(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, path string, result interface{}) error {
+	// The account number of Cloud accounts is derived from the tenant of the token
+	access, err := getIdentityAccessMemoized(ctx, d, h)
+	if err != nil {
+		return err
+	}
+	ran := cloudAccountNumberPrefix + access.(*IdentityAccess).Token.Tenant.ID
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://billing.api.rackspacecloud.com/v2/accounts/%s%s",
+		ran,
+		path,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp, "unexpected status")
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+//// TRANSFORM FUNCTIONS
+
+// billingAmount converts a billing amount, which the API may return as a
+// number or a string, to a float
+func billingAmount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	amount, _ := d.Value.(json.Number)
+	if amount == "" {
+		return nil, nil
+	}
+	return amount.Float64()
+}
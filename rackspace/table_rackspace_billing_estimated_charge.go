@@ -0,0 +1,57 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// BillingEstimatedCharge represents the estimated charges of a service for the current billing period
+type BillingEstimatedCharge struct {
+	ServiceType       string      `json:"serviceType"`
+	ServiceName       string      `json:"serviceName"`
+	CoverageStartDate string      `json:"coverageStartDate"`
+	CoverageEndDate   string      `json:"coverageEndDate"`
+	Amount            json.Number `json:"amount"`
+	Currency          string      `json:"currency"`
+}
+
+func tableRackspaceBillingEstimatedCharge() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_billing_estimated_charge",
+		Description: "Estimated charges per service of the Rackspace billing account for the current billing period.",
+		List: &plugin.ListConfig{
+			Hydrate: listBillingEstimatedCharges,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "service_type", Type: proto.ColumnType_STRING, Description: "The type of the service charged for."},
+			{Name: "service_name", Type: proto.ColumnType_STRING, Description: "The name of the service charged for."},
+			{Name: "coverage_start_date", Type: proto.ColumnType_TIMESTAMP, Description: "The start of the current billing period.", Transform: transform.FromField("CoverageStartDate").NullIfZero()},
+			{Name: "coverage_end_date", Type: proto.ColumnType_TIMESTAMP, Description: "The end of the current billing period.", Transform: transform.FromField("CoverageEndDate").NullIfZero()},
+			{Name: "amount", Type: proto.ColumnType_DOUBLE, Description: "The estimated amount charged for the service so far.", Transform: transform.FromField("Amount").Transform(billingAmount)},
+			{Name: "currency", Type: proto.ColumnType_STRING, Description: "The currency of the estimated charge."},
+		}),
+	}
+}
+
+func listBillingEstimatedCharges(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var result struct {
+		EstimatedCharges struct {
+			EstimatedCharge []BillingEstimatedCharge `json:"estimatedCharge"`
+		} `json:"estimatedCharges"`
+	}
+	if err := getBillingResource(ctx, d, h, "/estimated_charges", &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve estimated charges: %w", err)
+	}
+
+	// Stream each estimated charge to the table
+	for _, charge := range result.EstimatedCharges.EstimatedCharge {
+		d.StreamListItem(ctx, charge)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,82 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// BillingInvoice represents an invoice of the billing account
+type BillingInvoice struct {
+	ID                string      `json:"id"`
+	TranRefNum        string      `json:"tranRefNum"`
+	Type              string      `json:"type"`
+	Date              string      `json:"date"`
+	DueDate           string      `json:"dueDate"`
+	CoverageStartDate string      `json:"coverageStartDate"`
+	CoverageEndDate   string      `json:"coverageEndDate"`
+	Amount            json.Number `json:"amount"`
+	Currency          string      `json:"currency"`
+}
+
+func tableRackspaceBillingInvoice() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_billing_invoice",
+		Description: "Invoices of the Rackspace billing account of the tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listBillingInvoices,
+		},
+		Columns: resourceColumns(Resource{Type: "billing-invoice", IDField: "ID"}, []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the invoice."},
+			{Name: "tran_ref_num", Type: proto.ColumnType_STRING, Description: "The transaction reference number of the invoice."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the invoice."},
+			{Name: "date", Type: proto.ColumnType_TIMESTAMP, Description: "The date of the invoice.", Transform: transform.FromField("Date").NullIfZero()},
+			{Name: "due_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date the invoice is due.", Transform: transform.FromField("DueDate").NullIfZero()},
+			{Name: "coverage_start_date", Type: proto.ColumnType_TIMESTAMP, Description: "The start of the period covered by the invoice.", Transform: transform.FromField("CoverageStartDate").NullIfZero()},
+			{Name: "coverage_end_date", Type: proto.ColumnType_TIMESTAMP, Description: "The end of the period covered by the invoice.", Transform: transform.FromField("CoverageEndDate").NullIfZero()},
+			{Name: "total", Type: proto.ColumnType_DOUBLE, Description: "The total amount of the invoice.", Transform: transform.FromField("Amount").Transform(billingAmount)},
+			{Name: "currency", Type: proto.ColumnType_STRING, Description: "The currency of the invoice."},
+			{Name: "line_items", Type: proto.ColumnType_JSON, Description: "The line items of the invoice, with the service, description and amount of each charge.", Hydrate: getBillingInvoiceLineItems, Transform: transform.FromValue()},
+		}),
+	}
+}
+
+func listBillingInvoices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var result struct {
+		Invoices struct {
+			Invoice []BillingInvoice `json:"invoice"`
+		} `json:"invoices"`
+	}
+	if err := getBillingResource(ctx, d, h, "/invoices", &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve invoices: %w", err)
+	}
+
+	// Stream each invoice to the table
+	for _, invoice := range result.Invoices.Invoice {
+		d.StreamListItem(ctx, invoice)
+	}
+
+	return nil, nil
+}
+
+// getBillingInvoiceLineItems returns the line items of the invoice of the row
+func getBillingInvoiceLineItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	invoice := h.Item.(BillingInvoice)
+
+	var result struct {
+		InvoiceDetail struct {
+			InvoiceItem []json.RawMessage `json:"invoiceItem"`
+		} `json:"invoiceDetail"`
+	}
+	path := fmt.Sprintf("/invoices/%s/detail", url.PathEscape(invoice.ID))
+	if err := getBillingResource(ctx, d, h, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve line items of invoice %s: %w", invoice.ID, err)
+	}
+
+	return result.InvoiceDetail.InvoiceItem, nil
+}
@@ -0,0 +1,100 @@
+package rackspace
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/accounts"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// CloudFilesAccount represents the account-level information returned by the
+// Cloud Files account HEAD request. The temp URL keys themselves are never
+// exposed, only whether they are set.
+type CloudFilesAccount struct {
+	BytesUsed      int64
+	QuotaBytes     *int64
+	ContainerCount int64
+	ObjectCount    int64
+	TempURLKeySet  bool
+	TempURLKey2Set bool
+	Metadata       map[string]string
+}
+
+func tableRackspaceCloudFilesAccount() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_cloud_files_account",
+		Description: "Rackspace Cloud Files account-level usage and configuration.",
+		List: &plugin.ListConfig{
+			Hydrate: getCloudFilesAccount,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "bytes_used", Type: proto.ColumnType_INT, Description: "Total bytes stored across all containers in the account."},
+			{Name: "quota_bytes", Type: proto.ColumnType_INT, Description: "The bytes-used quota set on the account, if any."},
+			{Name: "container_count", Type: proto.ColumnType_INT, Description: "Number of containers in the account."},
+			{Name: "object_count", Type: proto.ColumnType_INT, Description: "Number of objects stored across all containers in the account."},
+			{Name: "temp_url_key_set", Type: proto.ColumnType_BOOL, Description: "Whether the Temp-URL-Key is set on the account.", Transform: transform.FromField("TempURLKeySet")},
+			{Name: "temp_url_key_2_set", Type: proto.ColumnType_BOOL, Description: "Whether the Temp-URL-Key-2 is set on the account.", Transform: transform.FromField("TempURLKey2Set")},
+			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "Metadata associated with the account, excluding the temp URL keys."},
+		}),
+	}
+}
+
+func getCloudFilesAccount(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Authenticate with Rackspace
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the region information
+	region, err := getRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create an Object Storage client
+	client, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{
+		Region: *region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the account headers
+	getResult := accounts.Get(ctx, client, accounts.GetOpts{})
+	header, err := getResult.Extract()
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := getResult.ExtractMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	// Never expose the temp URL keys, only whether they are set
+	for key := range metadata {
+		if strings.HasPrefix(strings.ToLower(key), "temp-url-key") {
+			delete(metadata, key)
+		}
+	}
+
+	account := CloudFilesAccount{
+		BytesUsed:      header.BytesUsed,
+		QuotaBytes:     header.QuotaBytes,
+		ContainerCount: header.ContainerCount,
+		ObjectCount:    header.ObjectCount,
+		TempURLKeySet:  header.TempURLKey != "",
+		TempURLKey2Set: header.TempURLKey2 != "",
+		Metadata:       metadata,
+	}
+
+	// Stream the account as a single row
+	d.StreamListItem(ctx, account)
+	return nil, nil
+}
@@ -10,8 +10,17 @@ import (
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+// ContainerDetail represents a container along with its metadata
+type ContainerDetail struct {
+	Name     string
+	Bytes    int64
+	Count    int64
+	Metadata map[string]string
+}
+
 func tableRackspaceCloudFilesContainer() *plugin.Table {
 	return &plugin.Table{
 		Name:        "rackspace_cloud_files_container",
@@ -23,12 +32,14 @@ func tableRackspaceCloudFilesContainer() *plugin.Table {
 			KeyColumns: plugin.SingleColumn("name"),
 			Hydrate:    getContainer,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "container", IDField: "Name"}, []*plugin.Column{
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the container."},
 			{Name: "bytes", Type: proto.ColumnType_INT, Description: "Total bytes stored in the container."},
 			{Name: "count", Type: proto.ColumnType_INT, Description: "Number of objects stored in the container."},
-			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "Metadata associated with the container."},
-		},
+			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "Metadata associated with the container.", Hydrate: getContainerMetadata, Transform: transform.FromValue()},
+			{Name: "tags", Type: proto.ColumnType_JSON, Description: "The tags of the container, from its metadata.", Hydrate: getContainerMetadata, Transform: transform.FromValue()},
+			{Name: "estimated_monthly_cost", Type: proto.ColumnType_DOUBLE, Description: "The monthly cost of the container estimated from the bytes stored, if a price catalog is configured.", Hydrate: getContainerEstimatedMonthlyCost, Transform: transform.FromValue()},
+		}),
 	}
 }
 
@@ -94,12 +105,7 @@ func getContainer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 	}
 
 	// Define struct with int64 for Count to match Gophercloud
-	var containerData struct {
-		Name     string
-		Bytes    int64
-		Count    int64
-		Metadata map[string]string
-	}
+	var containerData ContainerDetail
 
 	// Use List with Prefix option to find the exact container by name
 	opts := containers.ListOpts{Prefix: name}
@@ -136,3 +142,52 @@ func getContainer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 
 	return containerData, nil
 }
+
+// getContainerMetadata retrieves the metadata of the container, which listing containers doesn't return
+func getContainerMetadata(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	switch container := h.Item.(type) {
+	case containers.Container:
+		name = container.Name
+	case ContainerDetail:
+		return container.Metadata, nil
+	}
+
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	region, err := getRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{
+		Region: *region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return containers.Get(ctx, client, name, containers.GetOpts{}).ExtractMetadata()
+}
+
+// getContainerEstimatedMonthlyCost estimates the monthly cost of the container from the bytes stored
+func getContainerEstimatedMonthlyCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var bytes int64
+	switch container := h.Item.(type) {
+	case containers.Container:
+		bytes = container.Bytes
+	case ContainerDetail:
+		bytes = container.Bytes
+	}
+
+	return estimateMonthlyCost(ctx, d, h, func(catalog *PriceCatalog) *float64 {
+		if catalog.FilesGBStored == nil {
+			return nil
+		}
+		cost := *catalog.FilesGBStored * float64(bytes) / bytesPerGB
+		return &cost
+	})
+}
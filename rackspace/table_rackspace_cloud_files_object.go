@@ -23,7 +23,7 @@ func tableRackspaceCloudFilesObject() *plugin.Table {
 				{Name: "container_name", Require: plugin.Required},
 			},
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{Name: "container_name", Type: proto.ColumnType_STRING, Description: "The name of the container holding the object.", Transform: transform.FromQual("container_name")},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the object."},
 			{Name: "content_type", Type: proto.ColumnType_STRING, Description: "The content type of the object."},
@@ -33,7 +33,7 @@ func tableRackspaceCloudFilesObject() *plugin.Table {
 			{Name: "subdir", Type: proto.ColumnType_STRING, Description: "Whether the object contains a subdir."},
 			{Name: "is_latest", Type: proto.ColumnType_BOOL, Description: "Whether the object version is the latest one."},
 			{Name: "version_id", Type: proto.ColumnType_STRING, Description: "The version ID of the object, when versioning is enabled."},
-		},
+		}),
 	}
 }
 
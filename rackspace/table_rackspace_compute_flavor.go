@@ -2,6 +2,7 @@ package rackspace
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack"
@@ -13,6 +14,27 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+// Flavor wraps a gophercloud flavor with the extra specs that gophercloud
+// doesn't decode from the Rackspace response.
+type Flavor struct {
+	flavors.Flavor
+	FlavorExt
+}
+
+// UnmarshalJSON decodes both the gophercloud flavor and its extension
+// attributes, as the promoted flavors.Flavor.UnmarshalJSON only fills the former.
+func (f *Flavor) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &f.Flavor); err != nil {
+		return err
+	}
+	return json.Unmarshal(b, &f.FlavorExt)
+}
+
+// FlavorExt holds the Rackspace extension attributes of a flavor
+type FlavorExt struct {
+	RaxExtraSpecs map[string]string `json:"OS-FLV-WITH-EXT-SPECS:extra_specs"`
+}
+
 func tableRackspaceComputeFlavor() *plugin.Table {
 	return &plugin.Table{
 		Name:        "rackspace_compute_flavor",
@@ -24,7 +46,7 @@ func tableRackspaceComputeFlavor() *plugin.Table {
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getComputeFlavor,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "flavor", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the flavor."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the flavor."},
 			{Name: "ram", Type: proto.ColumnType_INT, Description: "The amount of RAM in MB."},
@@ -36,9 +58,14 @@ func tableRackspaceComputeFlavor() *plugin.Table {
 			{Name: "ephemeral", Type: proto.ColumnType_INT, Description: "The amount of ephemeral storage in GB.", Transform: transform.FromField("Ephemeral")},
 			// INFO: gophercloud can't unmarshal the extra_specs field ("OS-FLV-WITH-EXT-SPECS:extra_specs")
 			// Link: https://github.com/gophercloud/gophercloud/blob/c697dbb84b05feb3ccc8aa0e422306c205baf1de/openstack/compute/v2/flavors/results.go#L88
-			{Name: "extra_specs", Type: proto.ColumnType_JSON, Description: "The extra specifications of the flavor.", Transform: transform.FromField("ExtraSpecs")},
+			{Name: "extra_specs", Type: proto.ColumnType_JSON, Description: "The extra specifications of the flavor.", Transform: transform.FromField("RaxExtraSpecs")},
+			{Name: "class", Type: proto.ColumnType_STRING, Description: "The flavor class, such as general1 or compute1.", Transform: transform.FromField("RaxExtraSpecs.class")},
+			{Name: "policy_class", Type: proto.ColumnType_STRING, Description: "The policy class of the flavor.", Transform: transform.FromField("RaxExtraSpecs.policy_class")},
+			{Name: "resize_policy_class", Type: proto.ColumnType_STRING, Description: "The resize policy class of the flavor, restricting the flavors it can be resized to.", Transform: transform.FromField("RaxExtraSpecs.resize_policy_class")},
+			{Name: "number_of_data_disks", Type: proto.ColumnType_INT, Description: "The number of data disks of the flavor.", Transform: transform.FromField("RaxExtraSpecs.number_of_data_disks").Transform(transform.ToInt)},
+			{Name: "disk_io_index", Type: proto.ColumnType_INT, Description: "The relative disk I/O performance index of the flavor.", Transform: transform.FromField("RaxExtraSpecs.disk_io_index").Transform(transform.ToInt)},
 			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the flavor.", Transform: transform.FromField("Description")},
-		},
+		}),
 	}
 }
 
@@ -66,7 +93,8 @@ func listComputeFlavors(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	// List flavors
 	pager := flavors.ListDetail(client, flavors.ListOpts{})
 	err = pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
-		flavorList, err := flavors.ExtractFlavors(page)
+		var flavorList []Flavor
+		err := page.(flavors.FlavorPage).ExtractIntoSlicePtr(&flavorList, "flavors")
 		if err != nil {
 			return false, err
 		}
@@ -108,7 +136,8 @@ func getComputeFlavor(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	}
 
 	// Get the specific flavor by ID
-	flavor, err := flavors.Get(ctx, client, flavorID).Extract()
+	var flavor Flavor
+	err = flavors.Get(ctx, client, flavorID).ExtractIntoStructPtr(&flavor, "flavor")
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,84 @@
+package rackspace
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// FlavorAccess represents a tenant granted access to a private flavor
+type FlavorAccess struct {
+	flavors.FlavorAccess
+	FlavorName string
+}
+
+func tableRackspaceComputeFlavorAccess() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_compute_flavor_access",
+		Description: "Retrieve the tenants granted access to private Rackspace Compute flavors.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeFlavors,
+			Hydrate:       listComputeFlavorAccesses,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "flavor_id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the flavor.", Transform: transform.FromField("FlavorID")},
+			{Name: "flavor_name", Type: proto.ColumnType_STRING, Description: "The name of the flavor."},
+			{Name: "access_tenant_id", Type: proto.ColumnType_STRING, Description: "The ID of the tenant granted access to the flavor.", Transform: transform.FromField("TenantID")},
+		}),
+	}
+}
+
+func listComputeFlavorAccesses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	flavor := h.Item.(Flavor)
+
+	// Public flavors are available to every tenant
+	if flavor.IsPublic {
+		return nil, nil
+	}
+
+	// Authenticate with Rackspace
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the region information
+	region, err := getRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a Compute client
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: *region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// List the flavor accesses
+	pager := flavors.ListAccesses(client, flavor.ID)
+	err = pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		accessList, err := flavors.ExtractAccesses(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, access := range accessList {
+			d.StreamListItem(ctx, FlavorAccess{
+				FlavorAccess: access,
+				FlavorName:   flavor.Name,
+			})
+		}
+		return true, nil
+	})
+
+	return nil, err
+}
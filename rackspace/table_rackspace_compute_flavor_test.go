@@ -0,0 +1,34 @@
+package rackspace
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlavorUnmarshalJSON(t *testing.T) {
+	body := []byte(`{
+		"id": "general1-1",
+		"name": "1 GB General Purpose v1",
+		"ram": 1024,
+		"vcpus": 1,
+		"disk": 20,
+		"swap": "",
+		"OS-FLV-WITH-EXT-SPECS:extra_specs": {
+			"class": "general1",
+			"policy_class": "general_flavor",
+			"number_of_data_disks": "0"
+		}
+	}`)
+
+	var flavor Flavor
+	if err := json.Unmarshal(body, &flavor); err != nil {
+		t.Fatalf("unmarshal flavor: %v", err)
+	}
+
+	if flavor.ID != "general1-1" || flavor.RAM != 1024 {
+		t.Errorf("got id %q and ram %d, want general1-1 and 1024", flavor.ID, flavor.RAM)
+	}
+	if flavor.RaxExtraSpecs["class"] != "general1" || flavor.RaxExtraSpecs["policy_class"] != "general_flavor" {
+		t.Errorf("got extra specs %v, want class general1 and policy class general_flavor", flavor.RaxExtraSpecs)
+	}
+}
@@ -23,7 +23,7 @@ func tableRackspaceComputeKeyPair() *plugin.Table {
 			KeyColumns: plugin.SingleColumn("name"),
 			Hydrate:    getComputeKeypair,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "keypair", IDField: "Name"}, []*plugin.Column{
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the keypair."},
 			{Name: "public_key", Type: proto.ColumnType_STRING, Description: "The public key of the keypair."},
 			{Name: "fingerprint", Type: proto.ColumnType_STRING, Description: "The fingerprint of the keypair."},
@@ -33,7 +33,7 @@ func tableRackspaceComputeKeyPair() *plugin.Table {
 			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the keypair was last updated."},
 			{Name: "deleted_at", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the keypair was deleted."},
 			{Name: "deleted", Type: proto.ColumnType_BOOL, Description: "Whether the keypair is deleted."},
-		},
+		}),
 	}
 }
 
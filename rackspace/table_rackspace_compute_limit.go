@@ -19,7 +19,7 @@ func tableRackspaceComputeLimit() *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: getComputeLimit,
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{Name: "max_total_cores", Type: proto.ColumnType_INT, Description: "The maximum number of cores available to a tenant.", Transform: transform.FromField("MaxTotalCores")},
 			{Name: "max_image_meta", Type: proto.ColumnType_INT, Description: "The maximum amount of image metadata available to a tenant.", Transform: transform.FromField("MaxImageMeta")},
 			{Name: "max_server_meta", Type: proto.ColumnType_INT, Description: "The maximum amount of server metadata available to a tenant.", Transform: transform.FromField("MaxServerMeta")},
@@ -39,12 +39,24 @@ func tableRackspaceComputeLimit() *plugin.Table {
 			{Name: "total_ram_used", Type: proto.ColumnType_INT, Description: "The total amount of RAM currently in use in megabytes (MB).", Transform: transform.FromField("TotalRAMUsed")},
 			{Name: "total_security_groups_used", Type: proto.ColumnType_INT, Description: "The total number of security groups currently in use.", Transform: transform.FromField("TotalSecurityGroupsUsed")},
 			{Name: "total_server_groups_used", Type: proto.ColumnType_INT, Description: "The total number of server groups currently in use.", Transform: transform.FromField("TotalServerGroupsUsed")},
-		},
+		}),
 	}
 }
 
-// TODO: Use APIs to get the Rate data which are missing from gohercloud
+// Rate limits are missing from gophercloud, see rackspace_compute_rate_limit
 func getComputeLimit(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	absolute, err := getComputeAbsoluteLimits(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream limits data as a single row
+	d.StreamListItem(ctx, *absolute)
+	return nil, nil
+}
+
+// getComputeAbsoluteLimits retrieves the absolute compute limits of the tenant
+func getComputeAbsoluteLimits(ctx context.Context, d *plugin.QueryData) (*limits.Absolute, error) {
 	// Authenticate with Rackspace
 	provider, err := connect(ctx, d)
 	if err != nil {
@@ -71,7 +83,5 @@ func getComputeLimit(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 		return nil, err
 	}
 
-	// Stream limits data as a single row
-	d.StreamListItem(ctx, limitsData.Absolute)
-	return limitsData.Absolute, nil
+	return &limitsData.Absolute, nil
 }
@@ -0,0 +1,110 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// RateLimitGroup represents the rate limits applied to a URI
+type RateLimitGroup struct {
+	URI   string      `json:"uri"`
+	Regex string      `json:"regex"`
+	Limit []RateLimit `json:"limit"`
+}
+
+// RateLimit represents a single rate limit entry for an HTTP verb
+type RateLimit struct {
+	Verb          string `json:"verb"`
+	Value         int    `json:"value"`
+	Unit          string `json:"unit"`
+	Remaining     int    `json:"remaining"`
+	NextAvailable string `json:"next-available"`
+}
+
+// RateLimitRow represents a rate limit entry along with the URI it applies to
+type RateLimitRow struct {
+	RateLimit
+	URI   string
+	Regex string
+}
+
+func tableRackspaceComputeRateLimit() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_compute_rate_limit",
+		Description: "Retrieves Rackspace Compute rate limits for a tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeRateLimits,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "verb", Type: proto.ColumnType_STRING, Description: "The HTTP verb the limit applies to."},
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI the limit applies to.", Transform: transform.FromField("URI")},
+			{Name: "regex", Type: proto.ColumnType_STRING, Description: "The regular expression matching the URIs the limit applies to."},
+			{Name: "value", Type: proto.ColumnType_INT, Description: "The number of requests allowed per unit of time.", Transform: transform.FromField("Value")},
+			{Name: "unit", Type: proto.ColumnType_STRING, Description: "The unit of time of the limit, such as MINUTE or DAY."},
+			{Name: "remaining", Type: proto.ColumnType_INT, Description: "The number of requests remaining in the current period.", Transform: transform.FromField("Remaining")},
+			{Name: "next_available", Type: proto.ColumnType_TIMESTAMP, Description: "The time at which the limit resets."},
+		}),
+	}
+}
+
+// listComputeRateLimits fetches the compute /limits resource directly since
+// gophercloud only decodes the absolute limits.
+func listComputeRateLimits(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://%s.servers.api.rackspacecloud.com/v2/%s/limits",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "failed to retrieve compute limits")
+	}
+
+	// Parse the response
+	var result struct {
+		Limits struct {
+			Rate []RateLimitGroup `json:"rate"`
+		} `json:"limits"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// Stream one row per URI and verb
+	for _, group := range result.Limits.Rate {
+		for _, limit := range group.Limit {
+			d.StreamListItem(ctx, RateLimitRow{
+				RateLimit: limit,
+				URI:       group.URI,
+				Regex:     group.Regex,
+			})
+		}
+	}
+
+	return nil, nil
+}
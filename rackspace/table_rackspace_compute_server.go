@@ -2,7 +2,8 @@ package rackspace
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
+	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -14,25 +15,70 @@ import (
 	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
+// Server wraps a gophercloud server with the Rackspace-specific extension
+// attributes that gophercloud doesn't decode.
+type Server struct {
+	servers.Server
+	ServerExt
+}
+
+// UnmarshalJSON decodes both the gophercloud server and its extension
+// attributes, as the promoted servers.Server.UnmarshalJSON only fills the former.
+func (s *Server) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &s.Server); err != nil {
+		return err
+	}
+	return json.Unmarshal(b, &s.ServerExt)
+}
+
+// ServerExt holds the Rackspace extension attributes of a server
+type ServerExt struct {
+	PublicIPZoneID string               `json:"RAX-PUBLIC-IP-ZONE-ID:publicIPZoneId"`
+	Bandwidth      []ServerBandwidth    `json:"rax-bandwidth:bandwidth"`
+	ImageSchedule  *ServerImageSchedule `json:"RAX-SI-IMAGE-SCHEDULE:image_schedule"`
+}
+
+// ServerBandwidth represents the bandwidth usage of a server interface
+type ServerBandwidth struct {
+	Interface         string `json:"interface"`
+	BandwidthInbound  int64  `json:"bandwidth_inbound"`
+	BandwidthOutbound int64  `json:"bandwidth_outbound"`
+	AuditPeriodStart  string `json:"audit_period_start"`
+	AuditPeriodEnd    string `json:"audit_period_end"`
+}
+
+// ServerImageSchedule represents the scheduled image settings of a server
+type ServerImageSchedule struct {
+	Retention int `json:"retention"`
+}
+
 func tableRackspaceComputeServer() *plugin.Table {
 	return &plugin.Table{
 		Name:        "rackspace_compute_server",
 		Description: "Rackspace Compute Server (Nova)",
 		List: &plugin.ListConfig{
 			Hydrate: listComputeServers,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "image_id", Require: plugin.Optional},
+				{Name: "flavor_id", Require: plugin.Optional},
+				{Name: "host", Require: plugin.Optional},
+				{Name: "updated", Require: plugin.Optional, Operators: []string{">", ">="}},
+			},
 		},
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getComputeServer,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "server", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 
 			// Basic fields
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the server"},
 			{Name: "tenant_id", Type: proto.ColumnType_STRING, Description: "TenantID identifies the tenant owning this server resource"},
 			{Name: "user_id", Type: proto.ColumnType_STRING, Description: "UserID uniquely identifies the user account owning the tenant"},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name contains the human-readable name for the server"},
-			{Name: "updated", Type: proto.ColumnType_TIMESTAMP, Description: "Last updated timestamp"},
+			{Name: "updated", Type: proto.ColumnType_TIMESTAMP, Description: "Last updated timestamp. Deleted servers are excluded unless status = 'DELETED' is also given."},
 			{Name: "created", Type: proto.ColumnType_TIMESTAMP, Description: "Server creation timestamp"},
 			{Name: "host_id", Type: proto.ColumnType_STRING, Description: "HostID is the host where the server is located in the cloud"},
 			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status contains the current operational status of the server, such as IN_PROGRESS or ACTIVE."},
@@ -40,7 +86,9 @@ func tableRackspaceComputeServer() *plugin.Table {
 			{Name: "accessIPv4", Type: proto.ColumnType_STRING, Description: "IPv4 address of the server"},
 			{Name: "accessIPv6", Type: proto.ColumnType_STRING, Description: "IPv6 address of the server"},
 			{Name: "image", Type: proto.ColumnType_JSON, Description: "Image refers to a JSON object, which itself indicates the OS image used to deploy the server"},
+			{Name: "image_id", Type: proto.ColumnType_STRING, Description: "The ID of the image used to deploy the server", Transform: transform.FromField("Image.id")},
 			{Name: "flavor", Type: proto.ColumnType_JSON, Description: "Hardware configuration of the deployed server"},
+			{Name: "flavor_id", Type: proto.ColumnType_STRING, Description: "The ID of the flavor of the deployed server", Transform: transform.FromField("Flavor.id")},
 			{Name: "addresses", Type: proto.ColumnType_JSON, Description: "List of IP addresses assigned to the server"},
 			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "User-specified key-value pairs attached to the server"},
 			{Name: "links", Type: proto.ColumnType_JSON, Description: "Links includes HTTP references to itself"},
@@ -76,8 +124,12 @@ func tableRackspaceComputeServer() *plugin.Table {
 			{Name: "terminated_at", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp when the instance was terminated", Transform: transform.FromField("TerminatedAt")},
 
 			// Rackspace-specific fields (Not supported by gohercloud)
-			{Name: "public_ip_zone_id", Type: proto.ColumnType_STRING, Description: "Rackspace-specific public IP zone ID."},
-		},
+			{Name: "public_ip_zone_id", Type: proto.ColumnType_STRING, Description: "Rackspace-specific public IP zone ID.", Transform: transform.FromField("PublicIPZoneID")},
+			{Name: "bandwidth", Type: proto.ColumnType_JSON, Description: "Bandwidth usage per interface for the current audit period.", Transform: transform.FromField("Bandwidth")},
+			{Name: "estimated_monthly_cost", Type: proto.ColumnType_DOUBLE, Description: "The monthly cost of the server estimated from its flavor, if a price catalog is configured.", Hydrate: getServerEstimatedMonthlyCost, Transform: transform.FromValue()},
+			{Name: "image_schedule_enabled", Type: proto.ColumnType_BOOL, Description: "Whether scheduled images are enabled for the server.", Transform: transform.From(serverImageScheduleEnabled)},
+			{Name: "image_schedule_retention", Type: proto.ColumnType_INT, Description: "The number of scheduled images retained for the server.", Transform: transform.FromField("ImageSchedule.Retention")},
+		}),
 	}
 }
 
@@ -103,24 +155,51 @@ func listComputeServers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		return nil, err
 	}
 
-	opts2 := servers.ListOpts{}
-	pager := servers.List(client, opts2)
+	// Push down the supported quals as server-side filters
+	opts := servers.ListOpts{
+		Name:   d.EqualsQuals["name"].GetStringValue(),
+		Status: d.EqualsQuals["status"].GetStringValue(),
+		Image:  d.EqualsQuals["image_id"].GetStringValue(),
+		Flavor: d.EqualsQuals["flavor_id"].GetStringValue(),
+		Host:   d.EqualsQuals["host"].GetStringValue(),
+	}
+	// Use the latest of several updated quals, as Postgres applies all of them
+	if d.Quals["updated"] != nil {
+		var changesSince time.Time
+		for _, q := range d.Quals["updated"].Quals {
+			if updated := q.Value.GetTimestampValue().AsTime(); updated.After(changesSince) {
+				changesSince = updated
+			}
+		}
+		opts.ChangesSince = changesSince.Format(time.RFC3339)
+	}
+	pager := servers.List(client, opts)
 
-	var serverList []servers.Server
-	pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
-		serverList, err = servers.ExtractServers(page)
+	var serverList []Server
+	err = pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		serverList = nil
+		err := servers.ExtractServersInto(page, &serverList)
 
 		if err != nil {
 			return false, err
 		}
 
 		for _, s := range serverList {
+			// changes-since also returns deleted servers, which a plain list never does
+			if opts.ChangesSince != "" && s.Status == "DELETED" && opts.Status != "DELETED" {
+				continue
+			}
 			d.StreamListItem(ctx, s)
+
+			// Stop if the context is cancelled or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
 		}
 		return true, nil
 	})
 
-	return nil, nil
+	return nil, err
 }
 
 func getComputeServer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -128,7 +207,7 @@ func getComputeServer(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	provider, err := connect(ctx, d)
 
 	if err != nil {
-		fmt.Println(err)
+		plugin.Logger(ctx).Error("getComputeServer", "connection_error", err)
 		return nil, err
 	}
 
@@ -146,9 +225,31 @@ func getComputeServer(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		return nil, err
 	}
 
-	server, err := servers.Get(ctx, client, id).Extract()
+	var server Server
+	err = servers.Get(ctx, client, id).ExtractInto(&server)
 	if err != nil {
 		return nil, err
 	}
 	return server, nil
 }
+
+// getServerEstimatedMonthlyCost estimates the monthly cost of the server from its flavor
+func getServerEstimatedMonthlyCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(Server)
+	flavorID, _ := server.Flavor["id"].(string)
+
+	return estimateMonthlyCost(ctx, d, h, func(catalog *PriceCatalog) *float64 {
+		price, ok := catalog.Flavors[flavorID]
+		if !ok {
+			return nil
+		}
+		return &price
+	})
+}
+
+//// TRANSFORM FUNCTIONS
+
+func serverImageScheduleEnabled(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	server := d.HydrateItem.(Server)
+	return server.ImageSchedule != nil, nil
+}
@@ -0,0 +1,149 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Rackspace uses well-known IDs for the public and ServiceNet networks.
+const (
+	publicNetworkID  = "00000000-0000-0000-0000-000000000000"
+	privateNetworkID = "11111111-1111-1111-1111-111111111111"
+)
+
+// ServerAddress represents a single IP address assigned to a server on a network
+type ServerAddress struct {
+	ServerID     string
+	ServerName   string
+	NetworkLabel string
+	NetworkID    string
+	IPAddress    string
+	IPVersion    int
+}
+
+// ComputeNetwork represents a network from the Rackspace os-networksv2 extension
+type ComputeNetwork struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	CIDR  string `json:"cidr"`
+}
+
+func tableRackspaceComputeServerAddress() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_compute_server_address",
+		Description: "IP addresses assigned to Rackspace Compute Servers, one row per server, network and address.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeServers,
+			Hydrate:       listComputeServerAddresses,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the server", Transform: transform.FromField("ServerID")},
+			{Name: "server_name", Type: proto.ColumnType_STRING, Description: "The name of the server"},
+			{Name: "network_label", Type: proto.ColumnType_STRING, Description: "The label of the network, such as public, private or a custom network name"},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Description: "The ID of the network the address belongs to", Transform: transform.FromField("NetworkID")},
+			{Name: "ip_address", Type: proto.ColumnType_INET, Description: "The IP address assigned to the server", Transform: transform.FromField("IPAddress")},
+			{Name: "ip_version", Type: proto.ColumnType_INT, Description: "The IP version of the address (4 or 6)", Transform: transform.FromField("IPVersion")},
+		}),
+	}
+}
+
+// listComputeServerAddresses streams one row per address of each server
+func listComputeServerAddresses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(Server)
+
+	// Resolve network labels to IDs, fetched once per connection
+	networkIDs, err := getComputeNetworkIDsMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	for label, addresses := range server.Addresses {
+		entries, ok := addresses.([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, entry := range entries {
+			address, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			row := ServerAddress{
+				ServerID:     server.ID,
+				ServerName:   server.Name,
+				NetworkLabel: label,
+				NetworkID:    networkIDs.(map[string]string)[label],
+			}
+			if addr, ok := address["addr"].(string); ok {
+				row.IPAddress = addr
+			}
+			if version, ok := address["version"].(float64); ok {
+				row.IPVersion = int(version)
+			}
+			d.StreamListItem(ctx, row)
+		}
+	}
+
+	return nil, nil
+}
+
+var getComputeNetworkIDsMemoized = plugin.HydrateFunc(getComputeNetworkIDs).Memoize()
+
+// getComputeNetworkIDs returns a map of network label to network ID using the
+// Rackspace os-networksv2 extension, which gophercloud doesn't support.
+func getComputeNetworkIDs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://%s.servers.api.rackspacecloud.com/v2/%s/os-networksv2",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "failed to retrieve compute networks")
+	}
+
+	// Parse the response
+	var result struct {
+		Networks []ComputeNetwork `json:"networks"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// The public and ServiceNet networks are not always listed
+	networkIDs := map[string]string{
+		"public":  publicNetworkID,
+		"private": privateNetworkID,
+	}
+	for _, network := range result.Networks {
+		networkIDs[network.Label] = network.ID
+	}
+
+	return networkIDs, nil
+}
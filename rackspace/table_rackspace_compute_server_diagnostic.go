@@ -0,0 +1,85 @@
+package rackspace
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/diagnostics"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// ServerDiagnostic represents a single diagnostic value reported for a server
+type ServerDiagnostic struct {
+	ServerID   string
+	ServerName string
+	Name       string
+	Value      interface{}
+}
+
+func tableRackspaceComputeServerDiagnostic() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_compute_server_diagnostic",
+		Description: "Diagnostics of Rackspace Compute Servers, one row per server and diagnostic. Servers for which diagnostics are not permitted are skipped.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeServers,
+			Hydrate:       listComputeServerDiagnostics,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the server", Transform: transform.FromField("ServerID")},
+			{Name: "server_name", Type: proto.ColumnType_STRING, Description: "The name of the server"},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the diagnostic, such as cpu0 or memory"},
+			{Name: "value", Type: proto.ColumnType_JSON, Description: "The value of the diagnostic"},
+		}),
+	}
+}
+
+// listComputeServerDiagnostics streams one row per diagnostic of each server
+func listComputeServerDiagnostics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(Server)
+
+	// Authenticate with Rackspace
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the region information
+	region, err := getRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a Compute client
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: *region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Diagnostics are restricted by policy, so skip servers we aren't permitted to inspect
+	diags, err := diagnostics.Get(ctx, client, server.ID).Extract()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusForbidden) || gophercloud.ResponseCodeIs(err, http.StatusNotImplemented) {
+			plugin.Logger(ctx).Warn("listComputeServerDiagnostics", "server_id", server.ID, "error", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for name, value := range diags {
+		d.StreamListItem(ctx, ServerDiagnostic{
+			ServerID:   server.ID,
+			ServerName: server.Name,
+			Name:       name,
+			Value:      value,
+		})
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,164 @@
+package rackspace
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// ImageSchedule represents the scheduled image (auto-backup) policy of a server
+type ImageSchedule struct {
+	ServerID   string
+	ServerName string
+	Enabled    bool
+	Retention  int
+}
+
+// ServerImage represents a snapshot image taken from a server
+type ServerImage struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func tableRackspaceComputeServerImageSchedule() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_compute_server_image_schedule",
+		Description: "Scheduled image (auto-backup) policy and image history of Rackspace Compute Servers.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeServers,
+			Hydrate:       listComputeServerImageSchedules,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the server", Transform: transform.FromField("ServerID")},
+			{Name: "server_name", Type: proto.ColumnType_STRING, Description: "The name of the server"},
+			{Name: "enabled", Type: proto.ColumnType_BOOL, Description: "Whether scheduled images are enabled for the server", Transform: transform.FromField("Enabled")},
+			{Name: "retention", Type: proto.ColumnType_INT, Description: "The number of scheduled images retained for the server"},
+			{Name: "image_count", Type: proto.ColumnType_INT, Description: "The number of snapshot images taken from the server", Hydrate: getServerImageHistory, Transform: transform.FromValue().Transform(serverImageCount)},
+			{Name: "latest_image_created_at", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp of the most recent snapshot image taken from the server", Hydrate: getServerImageHistory, Transform: transform.FromValue().Transform(latestServerImageCreatedAt)},
+			{Name: "image_history", Type: proto.ColumnType_JSON, Description: "Snapshot images taken from the server, newest first", Hydrate: getServerImageHistory, Transform: transform.FromValue()},
+		}),
+	}
+}
+
+// listComputeServerImageSchedules streams the scheduled image policy of each
+// server, which the server body carries as RAX-SI-IMAGE-SCHEDULE:image_schedule.
+func listComputeServerImageSchedules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(Server)
+
+	schedule := ImageSchedule{
+		ServerID:   server.ID,
+		ServerName: server.Name,
+	}
+
+	// The attribute is absent when scheduled images are disabled for the server
+	if server.ImageSchedule != nil {
+		schedule.Enabled = true
+		schedule.Retention = server.ImageSchedule.Retention
+	}
+	d.StreamListItem(ctx, schedule)
+
+	return nil, nil
+}
+
+// getServerImageHistory returns the snapshot images taken from the server of the row
+func getServerImageHistory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	schedule := h.Item.(ImageSchedule)
+
+	// Snapshot images are listed once per connection and grouped by server
+	history, err := listServerImagesMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return history.(map[string][]ServerImage)[schedule.ServerID], nil
+}
+
+var listServerImagesMemoized = plugin.HydrateFunc(listServerImages).Memoize()
+
+// listServerImages returns the snapshot images of the tenant keyed by the ID
+// of the server they were taken from, newest first.
+func listServerImages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Authenticate with Rackspace
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the region information
+	region, err := getRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create an Image client
+	client, err := openstack.NewImageV2(provider, gophercloud.EndpointOpts{
+		Region: *region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Snapshots are always private to the tenant
+	history := map[string][]ServerImage{}
+	pager := images.List(client, images.ListOpts{Visibility: images.ImageVisibilityPrivate})
+	err = pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		imageList, err := images.ExtractImages(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, img := range imageList {
+			if imageType, _ := img.Properties["image_type"].(string); imageType != "snapshot" {
+				continue
+			}
+			serverID, _ := img.Properties["instance_uuid"].(string)
+			if serverID == "" {
+				continue
+			}
+			history[serverID] = append(history[serverID], ServerImage{
+				ID:        img.ID,
+				Name:      img.Name,
+				Status:    string(img.Status),
+				CreatedAt: img.CreatedAt,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, serverImages := range history {
+		sort.Slice(serverImages, func(i, j int) bool {
+			return serverImages[i].CreatedAt.After(serverImages[j].CreatedAt)
+		})
+	}
+
+	return history, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func serverImageCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	serverImages, _ := d.Value.([]ServerImage)
+	return len(serverImages), nil
+}
+
+func latestServerImageCreatedAt(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	serverImages, _ := d.Value.([]ServerImage)
+	if len(serverImages) == 0 {
+		return nil, nil
+	}
+	return serverImages[0].CreatedAt, nil
+}
@@ -0,0 +1,50 @@
+package rackspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// ServerMetadata represents a single metadata key-value pair of a server
+type ServerMetadata struct {
+	ServerID   string
+	ServerName string
+	Key        string
+	Value      string
+}
+
+func tableRackspaceComputeServerMetadata() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_compute_server_metadata",
+		Description: "Metadata of Rackspace Compute Servers, one row per server and key.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeServers,
+			Hydrate:       listComputeServerMetadata,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the server", Transform: transform.FromField("ServerID")},
+			{Name: "server_name", Type: proto.ColumnType_STRING, Description: "The name of the server"},
+			{Name: "key", Type: proto.ColumnType_STRING, Description: "The metadata key"},
+			{Name: "value", Type: proto.ColumnType_STRING, Description: "The metadata value"},
+		}),
+	}
+}
+
+// listComputeServerMetadata streams one row per metadata key of each server
+func listComputeServerMetadata(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(Server)
+
+	for key, value := range server.Metadata {
+		d.StreamListItem(ctx, ServerMetadata{
+			ServerID:   server.ID,
+			ServerName: server.Name,
+			Key:        key,
+			Value:      value,
+		})
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,55 @@
+package rackspace
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestServerUnmarshalJSON(t *testing.T) {
+	body := []byte(`{
+		"id": "a1b2c3",
+		"name": "web01",
+		"status": "ACTIVE",
+		"created": "2024-01-02T03:04:05Z",
+		"updated": "2024-01-02T03:04:05Z",
+		"RAX-PUBLIC-IP-ZONE-ID:publicIPZoneId": "zone-123",
+		"rax-bandwidth:bandwidth": [
+			{"interface": "public", "bandwidth_inbound": 100, "bandwidth_outbound": 200}
+		],
+		"RAX-SI-IMAGE-SCHEDULE:image_schedule": {"retention": 7}
+	}`)
+
+	var server Server
+	if err := json.Unmarshal(body, &server); err != nil {
+		t.Fatalf("unmarshal server: %v", err)
+	}
+
+	if server.ID != "a1b2c3" || server.Name != "web01" {
+		t.Errorf("got id %q and name %q, want a1b2c3 and web01", server.ID, server.Name)
+	}
+	if server.Created.IsZero() {
+		t.Error("created was not decoded")
+	}
+	if server.PublicIPZoneID != "zone-123" {
+		t.Errorf("got public IP zone ID %q, want zone-123", server.PublicIPZoneID)
+	}
+	if len(server.Bandwidth) != 1 || server.Bandwidth[0].Interface != "public" || server.Bandwidth[0].BandwidthOutbound != 200 {
+		t.Errorf("got bandwidth %+v, want one public interface with 200 outbound", server.Bandwidth)
+	}
+	if server.ImageSchedule == nil || server.ImageSchedule.Retention != 7 {
+		t.Errorf("got image schedule %+v, want retention 7", server.ImageSchedule)
+	}
+}
+
+func TestServerListUnmarshalJSON(t *testing.T) {
+	body := []byte(`[{"id": "a", "RAX-PUBLIC-IP-ZONE-ID:publicIPZoneId": "zone-a"}, {"id": "b"}]`)
+
+	var serverList []Server
+	if err := json.Unmarshal(body, &serverList); err != nil {
+		t.Fatalf("unmarshal servers: %v", err)
+	}
+
+	if len(serverList) != 2 || serverList[0].PublicIPZoneID != "zone-a" || serverList[1].PublicIPZoneID != "" {
+		t.Errorf("got servers %+v, want zone-a on the first server only", serverList)
+	}
+}
@@ -0,0 +1,139 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// VirtualInterface represents a server virtual interface from the Rackspace
+// os-virtual-interfacesv2 extension.
+type VirtualInterface struct {
+	ID          string                      `json:"id"`
+	MACAddress  string                      `json:"mac_address"`
+	IPAddresses []VirtualInterfaceIPAddress `json:"ip_addresses"`
+}
+
+// VirtualInterfaceIPAddress represents an IP address bound to a virtual interface
+type VirtualInterfaceIPAddress struct {
+	Address      string `json:"address"`
+	NetworkID    string `json:"network_id"`
+	NetworkLabel string `json:"network_label"`
+}
+
+// ServerVirtualInterfaceAddress represents a single address of a server virtual interface
+type ServerVirtualInterfaceAddress struct {
+	ServerID     string
+	ServerName   string
+	InterfaceID  string
+	MACAddress   string
+	NetworkID    string
+	NetworkLabel string
+	IPAddress    string
+	IPVersion    int
+}
+
+func tableRackspaceComputeServerVirtualInterface() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_compute_server_virtual_interface",
+		Description: "Virtual interfaces of Rackspace Compute Servers, one row per server, interface and address.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeServers,
+			Hydrate:       listComputeServerVirtualInterfaces,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the server", Transform: transform.FromField("ServerID")},
+			{Name: "server_name", Type: proto.ColumnType_STRING, Description: "The name of the server"},
+			{Name: "interface_id", Type: proto.ColumnType_STRING, Description: "The ID of the virtual interface", Transform: transform.FromField("InterfaceID")},
+			{Name: "mac_address", Type: proto.ColumnType_STRING, Description: "The MAC address of the virtual interface", Transform: transform.FromField("MACAddress")},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Description: "The ID of the network the interface is attached to", Transform: transform.FromField("NetworkID")},
+			{Name: "network_label", Type: proto.ColumnType_STRING, Description: "The label of the network the interface is attached to"},
+			{Name: "ip_address", Type: proto.ColumnType_INET, Description: "The IP address bound to the interface", Transform: transform.FromField("IPAddress")},
+			{Name: "ip_version", Type: proto.ColumnType_INT, Description: "The IP version of the address (4 or 6)", Transform: transform.FromField("IPVersion")},
+		}),
+	}
+}
+
+// listComputeServerVirtualInterfaces streams one row per virtual interface address of each server
+func listComputeServerVirtualInterfaces(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(Server)
+
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://%s.servers.api.rackspacecloud.com/v2/%s/servers/%s/os-virtual-interfacesv2",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+		server.ID,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "failed to retrieve virtual interfaces for server %s", server.ID)
+	}
+
+	// Parse the response
+	var result struct {
+		VirtualInterfaces []VirtualInterface `json:"virtual_interfaces"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// Stream each interface address to the table
+	for _, vif := range result.VirtualInterfaces {
+		// Interfaces without addresses still need a row to match ports by MAC address
+		if len(vif.IPAddresses) == 0 {
+			d.StreamListItem(ctx, ServerVirtualInterfaceAddress{
+				ServerID:    server.ID,
+				ServerName:  server.Name,
+				InterfaceID: vif.ID,
+				MACAddress:  vif.MACAddress,
+			})
+			continue
+		}
+
+		for _, address := range vif.IPAddresses {
+			row := ServerVirtualInterfaceAddress{
+				ServerID:     server.ID,
+				ServerName:   server.Name,
+				InterfaceID:  vif.ID,
+				MACAddress:   vif.MACAddress,
+				NetworkID:    address.NetworkID,
+				NetworkLabel: address.NetworkLabel,
+				IPAddress:    address.Address,
+			}
+			if ip := net.ParseIP(address.Address); ip != nil {
+				row.IPVersion = 6
+				if ip.To4() != nil {
+					row.IPVersion = 4
+				}
+			}
+			d.StreamListItem(ctx, row)
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,83 @@
+package rackspace
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/volumeattach"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// ServerVolumeAttachment represents a volume attachment of a server, along
+// with the server it belongs to.
+type ServerVolumeAttachment struct {
+	volumeattach.VolumeAttachment
+	ServerName string
+}
+
+func tableRackspaceComputeServerVolumeAttachment() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_compute_server_volume_attachment",
+		Description: "Volume attachments of Rackspace Compute Servers (os-volume_attachments).",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeServers,
+			Hydrate:       listComputeServerVolumeAttachments,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the volume attachment"},
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the server the volume is attached to", Transform: transform.FromField("ServerID")},
+			{Name: "server_name", Type: proto.ColumnType_STRING, Description: "The name of the server the volume is attached to"},
+			{Name: "volume_id", Type: proto.ColumnType_STRING, Description: "The ID of the attached volume", Transform: transform.FromField("VolumeID")},
+			{Name: "device", Type: proto.ColumnType_STRING, Description: "The device name of the attachment on the server (e.g., /dev/xvdb)"},
+		}),
+	}
+}
+
+// listComputeServerVolumeAttachments streams the volume attachments of each server
+func listComputeServerVolumeAttachments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(Server)
+
+	// Authenticate with Rackspace
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the region information
+	region, err := getRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a Compute client
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: *region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// List volume attachments
+	pager := volumeattach.List(client, server.ID)
+	err = pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		attachmentList, err := volumeattach.ExtractVolumeAttachments(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, attachment := range attachmentList {
+			d.StreamListItem(ctx, ServerVolumeAttachment{
+				VolumeAttachment: attachment,
+				ServerName:       server.Name,
+			})
+		}
+		return true, nil
+	})
+
+	return nil, err
+}
@@ -43,7 +43,7 @@ func tableRackspaceDNSDomain() *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listDNSDomains,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "dns-domain", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the DNS domain."},
 			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "The account ID associated with the DNS domain."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the DNS domain."},
@@ -52,13 +52,16 @@ func tableRackspaceDNSDomain() *plugin.Table {
 			{Name: "updated", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp when the DNS domain was last updated.", Transform: transform.FromField("Updated")},
 			{Name: "created", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp when the DNS domain was created.", Transform: transform.FromField("Created")},
 			{Name: "records_list", Type: proto.ColumnType_JSON, Description: "List of DNS records for the domain.", Hydrate: getDNSRecords, Transform: transform.FromValue()},
-		},
+		}),
 	}
 }
 
 func listDNSDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct API request URL
 	apiUrl := fmt.Sprintf(
@@ -72,18 +75,14 @@ func listDNSDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		return nil, err
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve DNS domains: %s", resp.Status)
+		return nil, responseError(resp, "failed to retrieve DNS domains")
 	}
 
 	// Parse the response
@@ -106,7 +105,10 @@ func getDNSRecords(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	domain := h.Item.(DNSDomain)
 
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct the API request URL for DNS records
 	apiUrl := fmt.Sprintf(
@@ -121,18 +123,14 @@ func getDNSRecords(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		return nil, err
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve DNS records for domain %s: %s", domain.Name, resp.Status)
+		return nil, responseError(resp, "failed to retrieve DNS records for domain %s", domain.Name)
 	}
 
 	// Parse the response into the records list
@@ -0,0 +1,122 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// DNSLimit represents a single absolute or rate limit of the DNS service
+type DNSLimit struct {
+	Type  string
+	Name  string
+	Value int
+	Verb  string
+	Unit  string
+	URI   string
+	Regex string
+}
+
+func tableRackspaceDNSLimit() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_dns_limit",
+		Description: "Retrieve the absolute and rate limits of Rackspace DNS.",
+		List: &plugin.ListConfig{
+			Hydrate: listDNSLimits,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the limit, either absolute or rate."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of an absolute limit, such as domains."},
+			{Name: "value", Type: proto.ColumnType_INT, Description: "The value of the limit.", Transform: transform.FromField("Value")},
+			{Name: "verb", Type: proto.ColumnType_STRING, Description: "The HTTP verb a rate limit applies to."},
+			{Name: "unit", Type: proto.ColumnType_STRING, Description: "The unit of time of a rate limit, such as SECOND or MINUTE."},
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI a rate limit applies to.", Transform: transform.FromField("URI")},
+			{Name: "regex", Type: proto.ColumnType_STRING, Description: "The regular expression matching the URIs a rate limit applies to."},
+		}),
+	}
+}
+
+func listDNSLimits(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	limits, err := getDNSLimits(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream each limit to the table
+	for _, limit := range limits {
+		d.StreamListItem(ctx, limit)
+	}
+
+	return nil, nil
+}
+
+// getDNSLimits retrieves the absolute and rate DNS limits of the tenant
+func getDNSLimits(ctx context.Context, d *plugin.QueryData) ([]DNSLimit, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://dns.api.rackspacecloud.com/v1.0/%s/limits",
+		*rackspaceConfig.TenantID,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "failed to retrieve DNS limits")
+	}
+
+	// Parse the response
+	var result struct {
+		Limits struct {
+			Rate     []RateLimitGroup `json:"rate"`
+			Absolute map[string]int   `json:"absolute"`
+		} `json:"limits"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	var limits []DNSLimit
+	for name, value := range result.Limits.Absolute {
+		limits = append(limits, DNSLimit{
+			Type:  "absolute",
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	for _, group := range result.Limits.Rate {
+		for _, limit := range group.Limit {
+			limits = append(limits, DNSLimit{
+				Type:  "rate",
+				Value: limit.Value,
+				Verb:  limit.Verb,
+				Unit:  limit.Unit,
+				URI:   group.URI,
+				Regex: group.Regex,
+			})
+		}
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,57 @@
+package rackspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// ServiceEndpoint represents an endpoint of the service catalog along with its service
+type ServiceEndpoint struct {
+	IdentityEndpoint
+	ServiceName string
+	ServiceType string
+}
+
+func tableRackspaceIdentityEndpoint() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_identity_endpoint",
+		Description: "Endpoints of the Rackspace service catalog for the current credential.",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityEndpoints,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "service_name", Type: proto.ColumnType_STRING, Description: "The name of the service, such as cloudServersOpenStack."},
+			{Name: "service_type", Type: proto.ColumnType_STRING, Description: "The type of the service, such as compute."},
+			{Name: "endpoint_region", Type: proto.ColumnType_STRING, Description: "The region of the endpoint, empty for global services.", Transform: transform.FromField("Region")},
+			{Name: "endpoint_tenant_id", Type: proto.ColumnType_STRING, Description: "The ID of the tenant the endpoint belongs to, such as the Cloud Files tenant.", Transform: transform.FromField("TenantID")},
+			{Name: "public_url", Type: proto.ColumnType_STRING, Description: "The public URL of the endpoint.", Transform: transform.FromField("PublicURL")},
+			{Name: "internal_url", Type: proto.ColumnType_STRING, Description: "The internal (ServiceNet) URL of the endpoint.", Transform: transform.FromField("InternalURL")},
+			{Name: "version_id", Type: proto.ColumnType_STRING, Description: "The version of the endpoint API.", Transform: transform.FromField("VersionID")},
+			{Name: "version_info", Type: proto.ColumnType_STRING, Description: "The URL describing the version of the endpoint API."},
+			{Name: "version_list", Type: proto.ColumnType_STRING, Description: "The URL listing the versions of the endpoint API."},
+		}),
+	}
+}
+
+func listIdentityEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	access, err := getIdentityAccessMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream each endpoint of each service to the table
+	for _, service := range access.(*IdentityAccess).ServiceCatalog {
+		for _, endpoint := range service.Endpoints {
+			d.StreamListItem(ctx, ServiceEndpoint{
+				IdentityEndpoint: endpoint,
+				ServiceName:      service.Name,
+				ServiceType:      service.Type,
+			})
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,126 @@
+package rackspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v2/tokens"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// IdentityAccess represents the response of the Rackspace identity v2.0
+// tokens API, including the RAX-AUTH extensions.
+type IdentityAccess struct {
+	Token          IdentityToken     `json:"token"`
+	ServiceCatalog []IdentityService `json:"serviceCatalog"`
+	User           IdentityTokenUser `json:"user"`
+}
+
+// IdentityToken represents the token of the current credential
+type IdentityToken struct {
+	ID              string         `json:"id"`
+	Expires         string         `json:"expires"`
+	Tenant          IdentityTenant `json:"tenant"`
+	AuthenticatedBy []string       `json:"RAX-AUTH:authenticatedBy"`
+}
+
+// IdentityTenant represents the tenant a token is scoped to
+type IdentityTenant struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// IdentityTokenUser represents the user a token was issued to
+type IdentityTokenUser struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	DefaultRegion string         `json:"RAX-AUTH:defaultRegion"`
+	Roles         []IdentityRole `json:"roles"`
+}
+
+// IdentityRole represents a role granted to a user
+type IdentityRole struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ServiceID   string `json:"serviceId"`
+	TenantID    string `json:"tenantId"`
+}
+
+// IdentityService represents a service of the service catalog
+type IdentityService struct {
+	Name      string             `json:"name"`
+	Type      string             `json:"type"`
+	Endpoints []IdentityEndpoint `json:"endpoints"`
+}
+
+// IdentityEndpoint represents an endpoint of a service in the service catalog
+type IdentityEndpoint struct {
+	TenantID    string `json:"tenantId"`
+	Region      string `json:"region"`
+	PublicURL   string `json:"publicURL"`
+	InternalURL string `json:"internalURL"`
+	VersionID   string `json:"versionId"`
+	VersionInfo string `json:"versionInfo"`
+	VersionList string `json:"versionList"`
+}
+
+func tableRackspaceIdentityToken() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_identity_token",
+		Description: "The Rackspace identity token of the current credential.",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityTokens,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "expires", Type: proto.ColumnType_TIMESTAMP, Description: "The time at which the token expires.", Transform: transform.FromField("Token.Expires")},
+			{Name: "tenant_id", Type: proto.ColumnType_STRING, Description: "The ID of the tenant the token is scoped to.", Transform: transform.FromField("Token.Tenant.ID")},
+			{Name: "tenant_name", Type: proto.ColumnType_STRING, Description: "The name of the tenant the token is scoped to.", Transform: transform.FromField("Token.Tenant.Name")},
+			{Name: "authenticated_by", Type: proto.ColumnType_JSON, Description: "The methods used to authenticate, such as PASSWORD or APIKEY.", Transform: transform.FromField("Token.AuthenticatedBy")},
+			{Name: "user_id", Type: proto.ColumnType_STRING, Description: "The ID of the user the token was issued to.", Transform: transform.FromField("User.ID")},
+			{Name: "user_name", Type: proto.ColumnType_STRING, Description: "The name of the user the token was issued to.", Transform: transform.FromField("User.Name")},
+			{Name: "default_region", Type: proto.ColumnType_STRING, Description: "The default region of the user.", Transform: transform.FromField("User.DefaultRegion")},
+			{Name: "roles", Type: proto.ColumnType_JSON, Description: "The roles granted to the user.", Transform: transform.FromField("User.Roles")},
+		}),
+	}
+}
+
+func listIdentityTokens(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	access, err := getIdentityAccessMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream the token as a single row
+	d.StreamListItem(ctx, access.(*IdentityAccess))
+	return nil, nil
+}
+
+var getIdentityAccessMemoized = plugin.HydrateFunc(getIdentityAccess).Memoize()
+
+// getIdentityAccess decodes the access response the client authenticated
+// with, including the RAX-AUTH extensions gophercloud doesn't extract.
+func getIdentityAccess(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The auth result is refreshed whenever the client re-authenticates
+	authResult, ok := provider.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return nil, fmt.Errorf("failed to retrieve identity token: unexpected auth result %T", provider.GetAuthResult())
+	}
+
+	var result struct {
+		Access IdentityAccess `json:"access"`
+	}
+	if err := authResult.ExtractInto(&result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve identity token: %w", err)
+	}
+
+	return &result.Access, nil
+}
@@ -0,0 +1,90 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// IdentityUser represents a user of the Rackspace identity v2.0 API
+type IdentityUser struct {
+	ID                 string `json:"id"`
+	Username           string `json:"username"`
+	Email              string `json:"email"`
+	Enabled            bool   `json:"enabled"`
+	DefaultRegion      string `json:"RAX-AUTH:defaultRegion"`
+	DomainID           string `json:"RAX-AUTH:domainId"`
+	MultiFactorEnabled bool   `json:"RAX-AUTH:multiFactorEnabled"`
+	Created            string `json:"created"`
+	Updated            string `json:"updated"`
+}
+
+func tableRackspaceIdentityUser() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_identity_user",
+		Description: "Users of the Rackspace account visible to the current credential.",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityUsers,
+		},
+		Columns: resourceColumns(Resource{Type: "user", IDField: "ID", TitleField: "Username"}, []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the user."},
+			{Name: "username", Type: proto.ColumnType_STRING, Description: "The username of the user."},
+			{Name: "email", Type: proto.ColumnType_STRING, Description: "The email address of the user."},
+			{Name: "enabled", Type: proto.ColumnType_BOOL, Description: "Whether the user is enabled.", Transform: transform.FromField("Enabled")},
+			{Name: "default_region", Type: proto.ColumnType_STRING, Description: "The default region of the user."},
+			{Name: "domain_id", Type: proto.ColumnType_STRING, Description: "The ID of the domain the user belongs to.", Transform: transform.FromField("DomainID")},
+			{Name: "multi_factor_enabled", Type: proto.ColumnType_BOOL, Description: "Whether multi-factor authentication is enabled for the user.", Transform: transform.FromField("MultiFactorEnabled")},
+			{Name: "created", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp when the user was created."},
+			{Name: "updated", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp when the user was last updated."},
+		}),
+	}
+}
+
+func listIdentityUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiUrl := strings.TrimSuffix(*rackspaceConfig.IdentityEndpoint, "/") + "/users"
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "failed to retrieve identity users")
+	}
+
+	// Parse the response
+	var result struct {
+		Users []IdentityUser `json:"users"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// Stream each user to the table
+	for _, user := range result.Users {
+		d.StreamListItem(ctx, user)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,94 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// IdentityUserRole represents a role granted to a user
+type IdentityUserRole struct {
+	IdentityRole
+	UserID   string
+	Username string
+}
+
+func tableRackspaceIdentityUserRole() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_identity_user_role",
+		Description: "Roles granted to the users of the Rackspace account, one row per user and role.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listIdentityUsers,
+			Hydrate:       listIdentityUserRoles,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "user_id", Type: proto.ColumnType_STRING, Description: "The ID of the user.", Transform: transform.FromField("UserID")},
+			{Name: "username", Type: proto.ColumnType_STRING, Description: "The username of the user."},
+			{Name: "role_id", Type: proto.ColumnType_STRING, Description: "The ID of the role.", Transform: transform.FromField("ID")},
+			{Name: "role_name", Type: proto.ColumnType_STRING, Description: "The name of the role, such as admin or identity:user-admin.", Transform: transform.FromField("Name")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the role."},
+			{Name: "service_id", Type: proto.ColumnType_STRING, Description: "The ID of the service the role applies to.", Transform: transform.FromField("ServiceID")},
+			{Name: "role_tenant_id", Type: proto.ColumnType_STRING, Description: "The ID of the tenant the role is scoped to, if any.", Transform: transform.FromField("TenantID")},
+		}),
+	}
+}
+
+func listIdentityUserRoles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	user := h.Item.(IdentityUser)
+
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"%s/users/%s/roles",
+		strings.TrimSuffix(*rackspaceConfig.IdentityEndpoint, "/"),
+		user.ID,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "failed to retrieve roles for user %s", user.Username)
+	}
+
+	// Parse the response
+	var result struct {
+		Roles []IdentityRole `json:"roles"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// Stream each role to the table
+	for _, role := range result.Roles {
+		d.StreamListItem(ctx, IdentityUserRole{
+			IdentityRole: role,
+			UserID:       user.ID,
+			Username:     user.Username,
+		})
+	}
+
+	return nil, nil
+}
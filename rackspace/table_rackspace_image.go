@@ -2,6 +2,7 @@ package rackspace
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -19,12 +20,20 @@ func tableRackspaceImage() *plugin.Table {
 		Description: "Rackspace Images (Glance)",
 		List: &plugin.ListConfig{
 			Hydrate: listImages,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "visibility", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "owner", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+				{Name: "tag", Require: plugin.Optional},
+				{Name: "member_status", Require: plugin.Optional},
+			},
 		},
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getImage,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "image", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the image"},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the image"},
 			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the image, e.g., 'queued', 'active'."},
@@ -46,7 +55,34 @@ func tableRackspaceImage() *plugin.Table {
 			{Name: "file", Type: proto.ColumnType_STRING, Description: "Location of the image file."},
 			{Name: "schema", Type: proto.ColumnType_STRING, Description: "Path to the JSON schema representing the image."},
 			{Name: "virtual_size", Type: proto.ColumnType_INT, Description: "Virtual size of the image, in bytes.", Transform: transform.FromField("VirtualSize")},
-		},
+
+			// Query-only fields
+			{Name: "tag", Type: proto.ColumnType_STRING, Description: "Filter images having the given tag.", Transform: transform.FromQual("tag")},
+			{Name: "member_status", Type: proto.ColumnType_STRING, Description: "Filter shared images by member status, e.g., 'accepted', 'pending' or 'all'.", Transform: transform.FromQual("member_status")},
+
+			// Image properties
+			{Name: "os_distro", Type: proto.ColumnType_STRING, Description: "The operating system distribution of the image, e.g., 'ubuntu'.", Transform: transform.FromP(imageProperty, "org.openstack__1__os_distro")},
+			{Name: "os_version", Type: proto.ColumnType_STRING, Description: "The operating system version of the image.", Transform: transform.FromP(imageProperty, "org.openstack__1__os_version")},
+			{Name: "architecture", Type: proto.ColumnType_STRING, Description: "The CPU architecture of the image, e.g., 'x64'.", Transform: transform.FromP(imageProperty, "org.openstack__1__architecture")},
+			{Name: "os_type", Type: proto.ColumnType_STRING, Description: "The operating system type of the image, e.g., 'linux' or 'windows'.", Transform: transform.FromP(imageProperty, "os_type")},
+			{Name: "image_type", Type: proto.ColumnType_STRING, Description: "The type of the image, e.g., 'base' or 'snapshot'.", Transform: transform.FromP(imageProperty, "image_type")},
+			{Name: "instance_uuid", Type: proto.ColumnType_STRING, Description: "The ID of the server a snapshot image was taken from.", Transform: transform.FromP(imageProperty, "instance_uuid")},
+			{Name: "vm_mode", Type: proto.ColumnType_STRING, Description: "The virtualization mode of the image, e.g., 'hvm'.", Transform: transform.FromP(imageProperty, "vm_mode")},
+			{Name: "auto_disk_config", Type: proto.ColumnType_BOOL, Description: "Whether the image supports automatic disk configuration.", Transform: transform.FromP(imageProperty, "auto_disk_config").Transform(imagePropertyToBool)},
+
+			// Rackspace image properties
+			{Name: "rackspace_release_id", Type: proto.ColumnType_STRING, Description: "The Rackspace release ID of the image.", Transform: transform.FromP(imageProperty, "com.rackspace__1__release_id")},
+			{Name: "rackspace_release_version", Type: proto.ColumnType_STRING, Description: "The Rackspace release version of the image.", Transform: transform.FromP(imageProperty, "com.rackspace__1__release_version")},
+			{Name: "rackspace_source", Type: proto.ColumnType_STRING, Description: "The source of the image, e.g., 'kickstart'.", Transform: transform.FromP(imageProperty, "com.rackspace__1__source")},
+			{Name: "rackspace_platform_target", Type: proto.ColumnType_STRING, Description: "The Rackspace platform the image targets, e.g., 'PublicCloud'.", Transform: transform.FromP(imageProperty, "com.rackspace__1__platform_target")},
+			{Name: "rackspace_options", Type: proto.ColumnType_STRING, Description: "Rackspace-specific image options.", Transform: transform.FromP(imageProperty, "com.rackspace__1__options")},
+			{Name: "rackspace_build_core", Type: proto.ColumnType_BOOL, Description: "Whether the image is built for Core accounts.", Transform: transform.FromP(imageProperty, "com.rackspace__1__build_core").Transform(imagePropertyToBool)},
+			{Name: "rackspace_build_managed", Type: proto.ColumnType_BOOL, Description: "Whether the image is built for Managed accounts.", Transform: transform.FromP(imageProperty, "com.rackspace__1__build_managed").Transform(imagePropertyToBool)},
+			{Name: "rackspace_build_rackconnect", Type: proto.ColumnType_BOOL, Description: "Whether the image is built for RackConnect accounts.", Transform: transform.FromP(imageProperty, "com.rackspace__1__build_rackconnect").Transform(imagePropertyToBool)},
+			{Name: "rackspace_visible_core", Type: proto.ColumnType_BOOL, Description: "Whether the image is visible to Core accounts.", Transform: transform.FromP(imageProperty, "com.rackspace__1__visible_core").Transform(imagePropertyToBool)},
+			{Name: "rackspace_visible_managed", Type: proto.ColumnType_BOOL, Description: "Whether the image is visible to Managed accounts.", Transform: transform.FromP(imageProperty, "com.rackspace__1__visible_managed").Transform(imagePropertyToBool)},
+			{Name: "rackspace_visible_rackconnect", Type: proto.ColumnType_BOOL, Description: "Whether the image is visible to RackConnect accounts.", Transform: transform.FromP(imageProperty, "com.rackspace__1__visible_rackconnect").Transform(imagePropertyToBool)},
+		}),
 	}
 }
 
@@ -73,11 +109,21 @@ func listImages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return nil, err
 	}
 
-	pager := images.List(client, images.ListOpts{})
+	// Push down the supported quals as server-side filters
+	opts := images.ListOpts{
+		Visibility:   images.ImageVisibility(d.EqualsQuals["visibility"].GetStringValue()),
+		Status:       images.ImageStatus(d.EqualsQuals["status"].GetStringValue()),
+		Owner:        d.EqualsQuals["owner"].GetStringValue(),
+		Name:         d.EqualsQuals["name"].GetStringValue(),
+		MemberStatus: images.ImageMemberStatus(d.EqualsQuals["member_status"].GetStringValue()),
+	}
+	if tag := d.EqualsQuals["tag"].GetStringValue(); tag != "" {
+		opts.Tags = []string{tag}
+	}
+	pager := images.List(client, opts)
 
-	var imageList []images.Image
-	pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
-		imageList, err = images.ExtractImages(page)
+	err = pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		imageList, err := images.ExtractImages(page)
 
 		if err != nil {
 			return false, err
@@ -89,7 +135,7 @@ func listImages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return true, nil
 	})
 
-	return nil, nil
+	return nil, err
 }
 
 // getImage fetches a single image by its ID
@@ -125,3 +171,30 @@ func getImage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 	}
 	return image, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+// imageProperty returns the image property named by the transform param
+func imageProperty(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var properties map[string]interface{}
+	switch item := d.HydrateItem.(type) {
+	case images.Image:
+		properties = item.Properties
+	case *images.Image:
+		properties = item.Properties
+	}
+	return properties[d.Param.(string)], nil
+}
+
+// imagePropertyToBool converts image properties such as "1" or "true" to a bool
+func imagePropertyToBool(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	value, ok := d.Value.(string)
+	if !ok {
+		return d.Value, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, nil
+	}
+	return b, nil
+}
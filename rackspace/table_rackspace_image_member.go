@@ -0,0 +1,143 @@
+package rackspace
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/members"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ImageMember represents a tenant an image is shared with, along with the image
+type ImageMember struct {
+	members.Member
+	ImageName  string
+	ImageOwner string
+	SharedByMe bool
+}
+
+func tableRackspaceImageMember() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_image_member",
+		Description: "Rackspace Image members, i.e. images shared to or from other tenants",
+		List: &plugin.ListConfig{
+			ParentHydrate: listSharedImages,
+			Hydrate:       listImageMembers,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "image_id", Type: proto.ColumnType_STRING, Description: "The ID of the shared image", Transform: transform.FromField("ImageID")},
+			{Name: "image_name", Type: proto.ColumnType_STRING, Description: "The name of the shared image"},
+			{Name: "image_owner", Type: proto.ColumnType_STRING, Description: "Tenant ID the shared image belongs to"},
+			{Name: "member_id", Type: proto.ColumnType_STRING, Description: "Tenant ID the image is shared with", Transform: transform.FromField("MemberID")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the membership, e.g., 'pending', 'accepted' or 'rejected'."},
+			{Name: "shared_by_me", Type: proto.ColumnType_BOOL, Description: "Whether the image is owned by the current tenant and shared to another tenant.", Transform: transform.FromField("SharedByMe")},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp when the membership was created."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Description: "Timestamp when the membership was last updated."},
+			{Name: "schema", Type: proto.ColumnType_STRING, Description: "Path to the JSON schema representing the member."},
+		}),
+	}
+}
+
+// listSharedImages streams the images that can have members: the private
+// images of the tenant and the images other tenants shared with it.
+func listSharedImages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch region from config
+	region, err := getRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err :=
+		openstack.NewImageV2(provider, gophercloud.EndpointOpts{
+			Region: *region,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	listOpts := []images.ListOpts{
+		{Visibility: images.ImageVisibilityPrivate},
+		{Visibility: images.ImageVisibilityShared, MemberStatus: images.ImageMemberStatusAll},
+	}
+	for _, opts := range listOpts {
+		err = images.List(client, opts).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+			imageList, err := images.ExtractImages(page)
+			if err != nil {
+				return false, err
+			}
+
+			for _, img := range imageList {
+				d.StreamListItem(ctx, img)
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// listImageMembers streams the members of each shared image
+func listImageMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	img := h.Item.(images.Image)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch region from config
+	region, err := getRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err :=
+		openstack.NewImageV2(provider, gophercloud.EndpointOpts{
+			Region: *region,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	err = members.List(client, img.ID).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		memberList, err := members.ExtractMembers(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, member := range memberList {
+			d.StreamListItem(ctx, ImageMember{
+				Member:     member,
+				ImageName:  img.Name,
+				ImageOwner: img.Owner,
+				SharedByMe: img.Owner == *rackspaceConfig.TenantID,
+			})
+		}
+		return true, nil
+	})
+
+	// Private images that were never shared have no member list
+	if gophercloud.ResponseCodeIs(err, http.StatusForbidden) || gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	return nil, err
+}
@@ -67,7 +67,7 @@ func tableRackspaceLoadBalancer() *plugin.Table {
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getLoadBalancer,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "loadbalancer", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_INT, Description: "The unique ID of the Load Balancer."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the Load Balancer."},
 			{Name: "protocol", Type: proto.ColumnType_STRING, Description: "The protocol used by the Load Balancer."},
@@ -85,13 +85,17 @@ func tableRackspaceLoadBalancer() *plugin.Table {
 			{Name: "content_caching", Type: proto.ColumnType_BOOL, Description: "Whether content caching is enabled for the Load Balancer.", Transform: transform.FromField("ContentCaching.Enabled")},
 			{Name: "cluster_name", Type: proto.ColumnType_STRING, Description: "The cluster name associated with the Load Balancer.", Transform: transform.FromField("Cluster.Name")},
 			{Name: "source_addresses", Type: proto.ColumnType_JSON, Description: "Source IP addresses for the Load Balancer, including IPv4 and IPv6 addresses."},
-		},
+			{Name: "estimated_monthly_cost", Type: proto.ColumnType_DOUBLE, Description: "The estimated monthly cost of the Load Balancer, if a price catalog is configured.", Hydrate: getLoadBalancerEstimatedMonthlyCost, Transform: transform.FromValue()},
+		}),
 	}
 }
 
 func listLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct API request URL
 	apiUrl := fmt.Sprintf(
@@ -106,18 +110,14 @@ func listLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 		return nil, err
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve load balancers: %s", resp.Status)
+		return nil, responseError(resp, "failed to retrieve load balancers")
 	}
 
 	// Parse the response
@@ -138,7 +138,10 @@ func listLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 func getLoadBalancer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 	loadBalancerID := d.EqualsQuals["id"].GetInt64Value()
 
 	// Construct the API request URL
@@ -155,18 +158,14 @@ func getLoadBalancer(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		return nil, err
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve load balancer: %s", resp.Status)
+		return nil, responseError(resp, "failed to retrieve load balancer")
 	}
 
 	// Parse the response
@@ -179,3 +178,10 @@ func getLoadBalancer(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 
 	return result.LoadBalancer, nil
 }
+
+// getLoadBalancerEstimatedMonthlyCost estimates the monthly cost of the load balancer
+func getLoadBalancerEstimatedMonthlyCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return estimateMonthlyCost(ctx, d, h, func(catalog *PriceCatalog) *float64 {
+		return catalog.LoadBalancer
+	})
+}
@@ -0,0 +1,88 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// AbsoluteLimit represents a named absolute limit
+type AbsoluteLimit struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func tableRackspaceLoadBalancerLimit() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_loadbalancer_limit",
+		Description: "Retrieve the absolute limits of Rackspace Load Balancers.",
+		List: &plugin.ListConfig{
+			Hydrate: listLoadBalancerLimits,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the limit, such as NODE_LIMIT."},
+			{Name: "value", Type: proto.ColumnType_INT, Description: "The value of the limit.", Transform: transform.FromField("Value")},
+		}),
+	}
+}
+
+func listLoadBalancerLimits(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	limits, err := getLoadBalancerAbsoluteLimits(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream each limit to the table
+	for _, limit := range limits {
+		d.StreamListItem(ctx, limit)
+	}
+
+	return nil, nil
+}
+
+// getLoadBalancerAbsoluteLimits retrieves the absolute load balancer limits of the tenant
+func getLoadBalancerAbsoluteLimits(ctx context.Context, d *plugin.QueryData) ([]AbsoluteLimit, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://%s.loadbalancers.api.rackspacecloud.com/v1.0/%s/loadbalancers/absolutelimits",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "failed to retrieve load balancer limits")
+	}
+
+	// Parse the response
+	var result struct {
+		Absolute []AbsoluteLimit `json:"absolute"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Absolute, nil
+}
@@ -46,18 +46,21 @@ func tableRackspaceMessageQueue() *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listQueues,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "queue", IDField: "Name"}, []*plugin.Column{
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the queue."},
 			{Name: "href", Type: proto.ColumnType_STRING, Description: "The URL of the queue."},
 			{Name: "stats", Type: proto.ColumnType_JSON, Description: "Statistics about the queue.", Hydrate: getQueueStats, Transform: transform.FromValue()},
 			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "Metadata associated with the queue.", Hydrate: getQueueMetadata, Transform: transform.FromValue()},
-		},
+		}),
 	}
 }
 
 func listQueues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct API request URL
 	apiUrl := fmt.Sprintf(
@@ -72,18 +75,14 @@ func listQueues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		return nil, err
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve queues: %s", resp.Status)
+		return nil, responseError(resp, "failed to retrieve queues")
 	}
 
 	// Parse the response
@@ -106,7 +105,10 @@ func getQueueStats(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	queue := h.Item.(MessageQueue)
 
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct the API request URL
 	apiUrl := fmt.Sprintf(
@@ -122,18 +124,14 @@ func getQueueStats(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		return nil, err
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve queue stats: %s", resp.Status)
+		return nil, responseError(resp, "failed to retrieve queue stats")
 	}
 
 	// Parse the response into the Stats struct
@@ -151,7 +149,10 @@ func getQueueMetadata(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	queue := h.Item.(MessageQueue)
 
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct the API request URL for metadata
 	apiUrl := fmt.Sprintf(
@@ -167,18 +168,14 @@ func getQueueMetadata(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		return nil, err
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve queue metadata: %s", resp.Status)
+		return nil, responseError(resp, "failed to retrieve queue metadata")
 	}
 
 	// Parse the response into a map
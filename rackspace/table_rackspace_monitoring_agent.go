@@ -0,0 +1,146 @@
+package rackspace
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// MonitoringAgent represents a Rackspace Cloud Monitoring agent
+type MonitoringAgent struct {
+	ID            string `json:"id"`
+	LastConnected int64  `json:"last_connected"`
+}
+
+// MonitoringEntity represents a Cloud Monitoring entity, i.e. a monitored host
+type MonitoringEntity struct {
+	ID      string `json:"id"`
+	Label   string `json:"label"`
+	AgentID string `json:"agent_id"`
+	URI     string `json:"uri"`
+}
+
+// monitoringEntityServerPattern extracts the server ID from the URI of an entity linked to a Cloud Server
+var monitoringEntityServerPattern = regexp.MustCompile(`/servers/([0-9a-fA-F-]+)$`)
+
+func tableRackspaceMonitoringAgent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_monitoring_agent",
+		Description: "Retrieve the Rackspace Cloud Monitoring agents that connected to the tenant.",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringAgents,
+		},
+		Columns: resourceColumns(Resource{Type: "monitoring-agent", IDField: "ID"}, []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the agent."},
+			{Name: "last_connected", Type: proto.ColumnType_TIMESTAMP, Description: "The time the agent last connected.", Transform: transform.FromField("LastConnected").Transform(transform.UnixMsToTimestamp)},
+			{Name: "entity_id", Type: proto.ColumnType_STRING, Description: "The ID of the monitoring entity the agent reports for.", Hydrate: getMonitoringAgentEntity, Transform: transform.FromField("ID")},
+			{Name: "entity_label", Type: proto.ColumnType_STRING, Description: "The label of the monitoring entity the agent reports for.", Hydrate: getMonitoringAgentEntity, Transform: transform.FromField("Label")},
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the compute server the agent runs on, if the entity is linked to one.", Hydrate: getMonitoringAgentEntity, Transform: transform.FromField("URI").Transform(monitoringEntityServerID)},
+		}),
+	}
+}
+
+func listMonitoringAgents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	marker := ""
+	for {
+		path := "agents"
+		if marker != "" {
+			path += "?marker=" + url.QueryEscape(marker)
+		}
+
+		var result struct {
+			Values   []MonitoringAgent `json:"values"`
+			Metadata struct {
+				NextMarker *string `json:"next_marker"`
+			} `json:"metadata"`
+		}
+		if err := getMonitoringResource(ctx, d, path, &result); err != nil {
+			return nil, fmt.Errorf("failed to retrieve monitoring agents: %w", err)
+		}
+
+		// Stream each agent to the table
+		for _, agent := range result.Values {
+			d.StreamListItem(ctx, agent)
+
+			// Stop if the context is cancelled or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// Continue with the next page, if any
+		if result.Metadata.NextMarker == nil || *result.Metadata.NextMarker == "" {
+			return nil, nil
+		}
+		marker = *result.Metadata.NextMarker
+	}
+}
+
+// getMonitoringAgentEntity returns the entity the agent of the row reports for
+func getMonitoringAgentEntity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	agent := h.Item.(MonitoringAgent)
+
+	// Entities are listed once per connection and keyed by agent ID
+	entities, err := listMonitoringEntitiesMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, ok := entities.(map[string]MonitoringEntity)[agent.ID]
+	if !ok {
+		return nil, nil
+	}
+	return entity, nil
+}
+
+var listMonitoringEntitiesMemoized = plugin.HydrateFunc(listMonitoringEntities).Memoize()
+
+// listMonitoringEntities returns the monitoring entities of the tenant keyed by agent ID
+func listMonitoringEntities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	entities := map[string]MonitoringEntity{}
+	marker := ""
+	for {
+		path := "entities"
+		if marker != "" {
+			path += "?marker=" + url.QueryEscape(marker)
+		}
+
+		var result struct {
+			Values   []MonitoringEntity `json:"values"`
+			Metadata struct {
+				NextMarker *string `json:"next_marker"`
+			} `json:"metadata"`
+		}
+		if err := getMonitoringResource(ctx, d, path, &result); err != nil {
+			return nil, fmt.Errorf("failed to retrieve monitoring entities: %w", err)
+		}
+
+		for _, entity := range result.Values {
+			if entity.AgentID != "" {
+				entities[entity.AgentID] = entity
+			}
+		}
+
+		// Continue with the next page, if any
+		if result.Metadata.NextMarker == nil || *result.Metadata.NextMarker == "" {
+			return entities, nil
+		}
+		marker = *result.Metadata.NextMarker
+	}
+}
+
+//// TRANSFORM FUNCTIONS
+
+func monitoringEntityServerID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	uri, _ := d.Value.(string)
+	match := monitoringEntityServerPattern.FindStringSubmatch(uri)
+	if match == nil {
+		return nil, nil
+	}
+	return match[1], nil
+}
@@ -0,0 +1,113 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// monitoringHostInfoTypes lists the host information types reported by the agent
+var monitoringHostInfoTypes = []string{"cpus", "memory", "filesystems", "disks", "processes", "network_interfaces", "system"}
+
+// MonitoringAgentHostInfo represents a single host information item reported by an agent
+type MonitoringAgentHostInfo struct {
+	AgentID   string
+	InfoType  string
+	Timestamp int64
+	Info      json.RawMessage
+}
+
+func tableRackspaceMonitoringAgentHostInfo() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_monitoring_agent_host_info",
+		Description: "Host information reported by Rackspace Cloud Monitoring agents, one row per CPU, filesystem, disk, process or network interface.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listMonitoringAgents,
+			Hydrate:       listMonitoringAgentHostInfo,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "agent_id", Require: plugin.Optional},
+				{Name: "info_type", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "agent_id", Type: proto.ColumnType_STRING, Description: "The ID of the agent reporting the information.", Transform: transform.FromField("AgentID")},
+			{Name: "info_type", Type: proto.ColumnType_STRING, Description: "The type of the information, one of cpus, memory, filesystems, disks, processes, network_interfaces or system."},
+			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Description: "The time the information was collected.", Transform: transform.FromField("Timestamp").Transform(transform.UnixMsToTimestamp)},
+			{Name: "info", Type: proto.ColumnType_JSON, Description: "The information item, such as a filesystem with its total, used and free space."},
+		}),
+	}
+}
+
+// listMonitoringAgentHostInfo streams the host information of each agent
+func listMonitoringAgentHostInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	agent := h.Item.(MonitoringAgent)
+
+	// Only query the requested agent
+	if agentID := d.EqualsQualString("agent_id"); agentID != "" && agentID != agent.ID {
+		return nil, nil
+	}
+
+	// Only query the requested information type. It is streamed back as is,
+	// so only the canonical lower case spelling can match the qual.
+	infoTypes := monitoringHostInfoTypes
+	if infoType := d.EqualsQualString("info_type"); infoType != "" {
+		valid := false
+		for _, t := range monitoringHostInfoTypes {
+			if t == infoType {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid info_type %q, must be one of %s (case-sensitive)", infoType, strings.Join(monitoringHostInfoTypes, ", "))
+		}
+		infoTypes = []string{infoType}
+	}
+
+	for _, infoType := range infoTypes {
+		var result struct {
+			Timestamp int64           `json:"timestamp"`
+			Info      json.RawMessage `json:"info"`
+		}
+		path := fmt.Sprintf("agents/%s/host_info/%s", url.PathEscape(agent.ID), infoType)
+		err := getMonitoringResource(ctx, d, path, &result)
+
+		// Agents that are not currently connected, or on platforms lacking an
+		// information type, cannot report it. Move on to the next type.
+		if gophercloud.ResponseCodeIs(err, http.StatusBadRequest) || gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			plugin.Logger(ctx).Warn("listMonitoringAgentHostInfo", "agent_id", agent.ID, "info_type", infoType, "error", err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve %s host info of agent %s: %w", infoType, agent.ID, err)
+		}
+
+		// Lists such as filesystems produce a row per item, objects such as memory a single row
+		items := []json.RawMessage{result.Info}
+		if strings.HasPrefix(strings.TrimSpace(string(result.Info)), "[") {
+			if err := json.Unmarshal(result.Info, &items); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, item := range items {
+			d.StreamListItem(ctx, MonitoringAgentHostInfo{
+				AgentID:   agent.ID,
+				InfoType:  infoType,
+				Timestamp: result.Timestamp,
+				Info:      item,
+			})
+		}
+	}
+
+	return nil, nil
+}
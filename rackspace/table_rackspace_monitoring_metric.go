@@ -0,0 +1,240 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// monitoringMetricResolutions lists the resolutions supported by the /plot endpoint
+var monitoringMetricResolutions = []string{"FULL", "MIN5", "MIN20", "MIN60", "MIN240", "MIN1440"}
+
+// MonitoringMetric represents a metric reported by a Cloud Monitoring check
+type MonitoringMetric struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Unit string `json:"unit"`
+}
+
+// MonitoringMetricPoint represents a single data point of a metric
+type MonitoringMetricPoint struct {
+	EntityID   string
+	CheckID    string
+	MetricName string
+	Unit       string
+	Resolution string
+	Timestamp  int64    `json:"timestamp"`
+	Average    *float64 `json:"average"`
+	Min        *float64 `json:"min"`
+	Max        *float64 `json:"max"`
+	NumPoints  int      `json:"numPoints"`
+}
+
+func tableRackspaceMonitoringMetric() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_monitoring_metric",
+		Description: "Time-series data points of Rackspace Cloud Monitoring check metrics.",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringMetricPoints,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "entity_id", Require: plugin.Required},
+				{Name: "check_id", Require: plugin.Required},
+				{Name: "metric_name", Require: plugin.Optional},
+				{Name: "resolution", Require: plugin.Optional},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "entity_id", Type: proto.ColumnType_STRING, Description: "The ID of the monitoring entity.", Transform: transform.FromField("EntityID")},
+			{Name: "check_id", Type: proto.ColumnType_STRING, Description: "The ID of the monitoring check.", Transform: transform.FromField("CheckID")},
+			{Name: "metric_name", Type: proto.ColumnType_STRING, Description: "The name of the metric, such as mzdfw.average."},
+			{Name: "unit", Type: proto.ColumnType_STRING, Description: "The unit of the metric, if known."},
+			{Name: "resolution", Type: proto.ColumnType_STRING, Description: "The resolution of the data points, one of FULL, MIN5, MIN20, MIN60, MIN240 or MIN1440. Defaults to FULL."},
+			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the data point. Defaults to the last 24 hours.", Transform: transform.FromField("Timestamp").Transform(transform.UnixMsToTimestamp)},
+			{Name: "average", Type: proto.ColumnType_DOUBLE, Description: "The average value of the metric over the data point."},
+			{Name: "min", Type: proto.ColumnType_DOUBLE, Description: "The minimum value of the metric over the data point, for rolled-up resolutions."},
+			{Name: "max", Type: proto.ColumnType_DOUBLE, Description: "The maximum value of the metric over the data point, for rolled-up resolutions."},
+			{Name: "num_points", Type: proto.ColumnType_INT, Description: "The number of raw points rolled up into the data point.", Transform: transform.FromField("NumPoints")},
+		}),
+	}
+}
+
+func listMonitoringMetricPoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	entityID := d.EqualsQualString("entity_id")
+	checkID := d.EqualsQualString("check_id")
+
+	// Validate the requested resolution. It is streamed back as is, so only
+	// the canonical upper case spelling can match the qual.
+	resolution := "FULL"
+	if value := d.EqualsQualString("resolution"); value != "" {
+		resolution = value
+		valid := false
+		for _, r := range monitoringMetricResolutions {
+			if r == resolution {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid resolution %q, must be one of %s (case-sensitive)", value, strings.Join(monitoringMetricResolutions, ", "))
+		}
+	}
+
+	// Derive the time range from the timestamp quals, taking the latest lower
+	// and earliest upper bound as Postgres applies all of them
+	var from, to time.Time
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			if q.Operator != "<" && q.Operator != "<=" && (from.IsZero() || timestamp.After(from)) {
+				from = timestamp
+			}
+			if q.Operator != ">" && q.Operator != ">=" && (to.IsZero() || timestamp.Before(to)) {
+				to = timestamp
+			}
+		}
+	}
+
+	// Default to the last 24 hours before the upper bound
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-24 * time.Hour)
+	}
+
+	// Plot the requested metric, or every metric of the check
+	var metrics []MonitoringMetric
+	if name := d.EqualsQualString("metric_name"); name != "" {
+		metrics = []MonitoringMetric{{Name: name}}
+	} else {
+		var err error
+		if metrics, err = listMonitoringCheckMetrics(ctx, d, entityID, checkID); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, metric := range metrics {
+		points, err := getMonitoringMetricPlot(ctx, d, entityID, checkID, metric.Name, resolution, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		// Stream each data point to the table
+		for _, point := range points {
+			point.EntityID = entityID
+			point.CheckID = checkID
+			point.MetricName = metric.Name
+			point.Unit = metric.Unit
+			point.Resolution = resolution
+			d.StreamListItem(ctx, point)
+
+			// Stop if the context is cancelled or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// listMonitoringCheckMetrics returns the metrics reported by a check
+func listMonitoringCheckMetrics(ctx context.Context, d *plugin.QueryData, entityID, checkID string) ([]MonitoringMetric, error) {
+	var metrics []MonitoringMetric
+	marker := ""
+	for {
+		path := fmt.Sprintf("entities/%s/checks/%s/metrics", url.PathEscape(entityID), url.PathEscape(checkID))
+		if marker != "" {
+			path += "?marker=" + url.QueryEscape(marker)
+		}
+
+		var result struct {
+			Values   []MonitoringMetric `json:"values"`
+			Metadata struct {
+				NextMarker *string `json:"next_marker"`
+			} `json:"metadata"`
+		}
+		if err := getMonitoringResource(ctx, d, path, &result); err != nil {
+			return nil, fmt.Errorf("failed to retrieve metrics of check %s: %w", checkID, err)
+		}
+		metrics = append(metrics, result.Values...)
+
+		// Continue with the next page, if any
+		if result.Metadata.NextMarker == nil || *result.Metadata.NextMarker == "" {
+			return metrics, nil
+		}
+		marker = *result.Metadata.NextMarker
+	}
+}
+
+// getMonitoringMetricPlot returns the data points of a metric between from and to
+func getMonitoringMetricPlot(ctx context.Context, d *plugin.QueryData, entityID, checkID, metricName, resolution string, from, to time.Time) ([]MonitoringMetricPoint, error) {
+	params := url.Values{}
+	params.Set("from", fmt.Sprint(from.UnixMilli()))
+	params.Set("to", fmt.Sprint(to.UnixMilli()))
+	params.Set("resolution", resolution)
+	for _, field := range []string{"average", "min", "max", "numPoints"} {
+		params.Add("select", field)
+	}
+
+	path := fmt.Sprintf(
+		"entities/%s/checks/%s/metrics/%s/plot?%s",
+		url.PathEscape(entityID),
+		url.PathEscape(checkID),
+		url.PathEscape(metricName),
+		params.Encode(),
+	)
+
+	var result struct {
+		Values []MonitoringMetricPoint `json:"values"`
+	}
+	if err := getMonitoringResource(ctx, d, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to plot metric %s: %w", metricName, err)
+	}
+
+	return result.Values, nil
+}
+
+// getMonitoringResource performs an authenticated GET request against the
+// Cloud Monitoring API of the tenant and decodes the JSON response into result.
+func getMonitoringResource(ctx context.Context, d *plugin.QueryData, path string, result interface{}) error {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://monitoring.api.rackspacecloud.com/v1.0/%s/%s",
+		*rackspaceConfig.TenantID,
+		path,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp, "unexpected status")
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -3,8 +3,6 @@ package rackspace
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -26,20 +24,23 @@ type Network struct {
 	Shared       bool     `json:"shared"`
 }
 
-// Link represents pagination links for the networks response.
-type Link struct {
-	Href string `json:"href"`
-	Rel  string `json:"rel"`
-}
-
 func tableRackspaceNetwork() *plugin.Table {
 	return &plugin.Table{
 		Name:        "rackspace_network",
 		Description: "Retrieve details of Rackspace Networks.",
 		List: &plugin.ListConfig{
 			Hydrate: listNetworks,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "tenant_id", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getNetwork,
+		},
+		Columns: resourceColumns(Resource{Type: "network", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique ID of the network."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the network."},
 			{Name: "admin_state_up", Type: proto.ColumnType_BOOL, Description: "The administrative state of the network."},
@@ -47,58 +48,37 @@ func tableRackspaceNetwork() *plugin.Table {
 			{Name: "subnets", Type: proto.ColumnType_JSON, Description: "List of subnets associated with the network."},
 			{Name: "tenant_id", Type: proto.ColumnType_STRING, Description: "The ID of the tenant that owns the network."},
 			{Name: "shared", Type: proto.ColumnType_BOOL, Description: "Whether the network is shared across tenants."},
-		},
+		}),
 	}
 }
 
 func listNetworks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	rackspaceConfig := GetConfig(d.Connection)
-	client := &http.Client{}
-	nextPage := fmt.Sprintf("https://%s.networks.api.rackspacecloud.com/v2.0/networks", *rackspaceConfig.Region)
-
-	// Continue paging through until no further pages are available.
-	for nextPage != "" {
-		req, err := http.NewRequest("GET", nextPage, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Set the Authorization header
-		req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
-		resp, err := client.Do(req)
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("failed to retrieve networks: %s", resp.Status)
-		}
+	// Push down the filters supported by the API
+	params := buildNetworkQueryParams(d, map[string]string{
+		"name":      "name",
+		"tenant_id": "tenant_id",
+		"status":    "status",
+	})
 
-		// Parse the response
-		var result struct {
-			Networks      []Network `json:"networks"`
-			NetworksLinks []Link    `json:"networks_links"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			return nil, err
+	// Stream each network to the table
+	err := listNetworkCollection(ctx, d, "networks", "networks", params, func(item json.RawMessage) error {
+		var network Network
+		if err := json.Unmarshal(item, &network); err != nil {
+			return err
 		}
+		d.StreamListItem(ctx, network)
+		return nil
+	})
 
-		// Stream each network to the table
-		for _, network := range result.Networks {
-			d.StreamListItem(ctx, network)
-		}
+	return nil, err
+}
 
-		// Check if there is a next page link
-		nextPage = ""
-		for _, link := range result.NetworksLinks {
-			if link.Rel == "next" {
-				nextPage = link.Href
-				break
-			}
-		}
+// getNetwork fetches a single network by its ID
+func getNetwork(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	var network Network
+	found, err := getNetworkResource(ctx, d, "networks/"+d.EqualsQualString("id"), "network", &network)
+	if err != nil || !found {
+		return nil, err
 	}
-
-	return nil, nil
+	return network, nil
 }
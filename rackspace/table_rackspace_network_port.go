@@ -3,8 +3,7 @@ package rackspace
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
+	"strings"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -38,8 +37,22 @@ func tableRackspaceNetworkPort() *plugin.Table {
 		Description: "Retrieve details of Rackspace network ports.",
 		List: &plugin.ListConfig{
 			Hydrate: listNetworkPorts,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "network_id", Require: plugin.Optional},
+				{Name: "device_id", Require: plugin.Optional},
+				{Name: "server_id", Require: plugin.Optional},
+				{Name: "device_owner", Require: plugin.Optional},
+				{Name: "mac_address", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+				{Name: "tenant_id", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getNetworkPort,
+		},
+		Columns: resourceColumns(Resource{Type: "port", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the network port."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the network port."},
 			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the network port."},
@@ -51,52 +64,54 @@ func tableRackspaceNetworkPort() *plugin.Table {
 			{Name: "fixed_ips", Type: proto.ColumnType_JSON, Description: "List of fixed IP addresses associated with the network port.", Transform: transform.FromField("FixedIPs")},
 			{Name: "security_groups", Type: proto.ColumnType_JSON, Description: "List of security groups associated with the network port."},
 			{Name: "device_id", Type: proto.ColumnType_STRING, Description: "The ID of the device using this network port."},
-		},
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the compute server using this network port, if any.", Transform: transform.FromValue().Transform(networkPortServerID)},
+		}),
 	}
 }
 
 func listNetworkPorts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
-
-	// Construct API request URL
-	apiUrl := fmt.Sprintf(
-		"https://%s.networks.api.rackspacecloud.com/v2.0/ports",
-		*rackspaceConfig.Region,
-	)
+	// Push down the filters supported by the API, a server is the device of its ports
+	params := buildNetworkQueryParams(d, map[string]string{
+		"network_id":   "network_id",
+		"device_id":    "device_id",
+		"server_id":    "device_id",
+		"device_owner": "device_owner",
+		"mac_address":  "mac_address",
+		"name":         "name",
+		"tenant_id":    "tenant_id",
+		"status":       "status",
+	})
 
-	// Create an HTTP request
-	req, err := http.NewRequest("GET", apiUrl, nil)
-	if err != nil {
-		return nil, err
-	}
+	// Stream each port to the table
+	err := listNetworkCollection(ctx, d, "ports", "ports", params, func(item json.RawMessage) error {
+		var port NetworkPort
+		if err := json.Unmarshal(item, &port); err != nil {
+			return err
+		}
+		d.StreamListItem(ctx, port)
+		return nil
+	})
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
+	return nil, err
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
+// getNetworkPort fetches a single port by its ID
+func getNetworkPort(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	var port NetworkPort
+	found, err := getNetworkResource(ctx, d, "ports/"+d.EqualsQualString("id"), "port", &port)
+	if err != nil || !found {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve network ports: %s", resp.Status)
-	}
+	return port, nil
+}
 
-	// Parse the response
-	var result struct {
-		Ports []NetworkPort `json:"ports"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
+//// TRANSFORM FUNCTIONS
 
-	// Stream each port to the table
-	for _, port := range result.Ports {
-		d.StreamListItem(ctx, port)
+// networkPortServerID returns the device ID of ports owned by a compute server
+func networkPortServerID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	port := d.Value.(NetworkPort)
+	if !strings.HasPrefix(port.DeviceOwner, "compute:") || port.DeviceID == "" {
+		return nil, nil
 	}
-
-	return nil, nil
+	return port.DeviceID, nil
 }
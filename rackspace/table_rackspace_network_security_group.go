@@ -3,8 +3,6 @@ package rackspace
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -44,8 +42,16 @@ func tableRackspaceNetworkSecurityGroup() *plugin.Table {
 		Description: "Retrieve details of Rackspace network security groups.",
 		List: &plugin.ListConfig{
 			Hydrate: listSecurityGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "tenant_id", Require: plugin.Optional},
+			},
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getSecurityGroup,
+		},
+		Columns: resourceColumns(Resource{Type: "security-group", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the security group."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the security group."},
 			{Name: "tenant_id", Type: proto.ColumnType_STRING, Description: "The ID of the tenant associated with the security group."},
@@ -53,52 +59,36 @@ func tableRackspaceNetworkSecurityGroup() *plugin.Table {
 			{Name: "external_service_id", Type: proto.ColumnType_STRING, Description: "External service ID associated with the security group, if any."},
 			{Name: "external_service", Type: proto.ColumnType_STRING, Description: "External service name associated with the security group, if any."},
 			{Name: "security_group_rules", Type: proto.ColumnType_JSON, Description: "List of rules associated with the security group."},
-		},
+		}),
 	}
 }
 
 func listSecurityGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
-
-	// Construct API request URL
-	apiUrl := fmt.Sprintf(
-		"https://%s.networks.api.rackspacecloud.com/v2.0/security-groups",
-		*rackspaceConfig.Region,
-	)
-
-	// Create an HTTP request
-	req, err := http.NewRequest("GET", apiUrl, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
+	// Push down the filters supported by the API
+	params := buildNetworkQueryParams(d, map[string]string{
+		"name":      "name",
+		"tenant_id": "tenant_id",
+	})
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	// Stream each security group to the table
+	err := listNetworkCollection(ctx, d, "security-groups", "security_groups", params, func(item json.RawMessage) error {
+		var group SecurityGroup
+		if err := json.Unmarshal(item, &group); err != nil {
+			return err
+		}
+		d.StreamListItem(ctx, group)
+		return nil
+	})
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve security groups: %s", resp.Status)
-	}
+	return nil, err
+}
 
-	// Parse the response
-	var result struct {
-		SecurityGroups []SecurityGroup `json:"security_groups"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+// getSecurityGroup fetches a single security group by its ID
+func getSecurityGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	var group SecurityGroup
+	found, err := getNetworkResource(ctx, d, "security-groups/"+d.EqualsQualString("id"), "security_group", &group)
+	if err != nil || !found {
 		return nil, err
 	}
-
-	// Stream each security group to the table
-	for _, group := range result.SecurityGroups {
-		d.StreamListItem(ctx, group)
-	}
-
-	return nil, nil
+	return group, nil
 }
@@ -0,0 +1,54 @@
+package rackspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// SecurityGroupRuleRow represents a security group rule along with its group
+type SecurityGroupRuleRow struct {
+	SecurityGroupRule
+	SecurityGroupName string
+}
+
+func tableRackspaceNetworkSecurityGroupRule() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_network_security_group_rule",
+		Description: "Retrieve the rules of Rackspace network security groups, one row per rule.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listSecurityGroups,
+			Hydrate:       listSecurityGroupRules,
+		},
+		Columns: resourceColumns(Resource{Type: "security-group-rule", IDField: "ID"}, []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the security group rule."},
+			{Name: "security_group_id", Type: proto.ColumnType_STRING, Description: "The ID of the security group the rule belongs to.", Transform: transform.FromField("SecurityGroupID")},
+			{Name: "security_group_name", Type: proto.ColumnType_STRING, Description: "The name of the security group the rule belongs to."},
+			{Name: "direction", Type: proto.ColumnType_STRING, Description: "The direction of the traffic the rule applies to, either `ingress` or `egress`."},
+			{Name: "ethertype", Type: proto.ColumnType_STRING, Description: "The ethertype of the traffic, either `IPv4` or `IPv6`.", Transform: transform.FromField("EtherType")},
+			{Name: "protocol", Type: proto.ColumnType_STRING, Description: "The IP protocol the rule applies to, such as `tcp`, `udp` or `icmp`. Null matches any protocol."},
+			{Name: "port_range_min", Type: proto.ColumnType_INT, Description: "The minimum port number matched by the rule.", Transform: transform.FromField("PortRangeMin")},
+			{Name: "port_range_max", Type: proto.ColumnType_INT, Description: "The maximum port number matched by the rule.", Transform: transform.FromField("PortRangeMax")},
+			{Name: "remote_ip_prefix", Type: proto.ColumnType_CIDR, Description: "The remote CIDR matched by the rule, usable with CIDR containment operators such as `>>=`.", Transform: transform.FromField("RemoteIPPrefix")},
+			{Name: "remote_group_id", Type: proto.ColumnType_STRING, Description: "The ID of the remote security group matched by the rule.", Transform: transform.FromField("RemoteGroupID")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the security group rule."},
+			{Name: "tenant_id", Type: proto.ColumnType_STRING, Description: "The ID of the tenant that owns the security group rule.", Transform: transform.FromField("TenantID")},
+		}),
+	}
+}
+
+// listSecurityGroupRules streams each rule of the security group
+func listSecurityGroupRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(SecurityGroup)
+
+	for _, rule := range group.SecurityGroupRules {
+		d.StreamListItem(ctx, SecurityGroupRuleRow{
+			SecurityGroupRule: rule,
+			SecurityGroupName: group.Name,
+		})
+	}
+
+	return nil, nil
+}
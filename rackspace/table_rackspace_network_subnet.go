@@ -3,16 +3,12 @@ package rackspace
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
-const listNetworkSubnetsUrl = "https://%s.networks.api.rackspacecloud.com/v2.0/subnets"
-
 // NetworkSubnet represents a Rackspace network subnet
 type NetworkSubnet struct {
 	ID              string           `json:"id"`
@@ -40,8 +36,18 @@ func tableRackspaceNetworkSubnet() *plugin.Table {
 		Description: "Retrieve details of Rackspace network subnets.",
 		List: &plugin.ListConfig{
 			Hydrate: listNetworkSubnets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "network_id", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+				{Name: "tenant_id", Require: plugin.Optional},
+				{Name: "cidr", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getNetworkSubnet,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "subnet", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique identifier of the subnet."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the subnet."},
 			{Name: "enable_dhcp", Type: proto.ColumnType_BOOL, Description: "Indicates if DHCP is enabled on the subnet.", Transform: transform.FromField("EnableDHCP")},
@@ -53,52 +59,38 @@ func tableRackspaceNetworkSubnet() *plugin.Table {
 			{Name: "ip_version", Type: proto.ColumnType_INT, Description: "IP version used by the subnet (e.g., 4 for IPv4).", Transform: transform.FromField("IPVersion")},
 			{Name: "gateway_ip", Type: proto.ColumnType_STRING, Description: "The IP address of the subnet gateway.", Transform: transform.FromField("GatewayIP")},
 			{Name: "cidr", Type: proto.ColumnType_STRING, Description: "The CIDR of the subnet.", Transform: transform.FromField("CIDR")},
-		},
+		}),
 	}
 }
 
 func listNetworkSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
-
-	// Construct API request URL
-	apiUrl := fmt.Sprintf(
-		listNetworkSubnetsUrl,
-		*rackspaceConfig.Region,
-	)
-
-	// Create an HTTP request
-	req, err := http.NewRequest("GET", apiUrl, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
+	// Push down the filters supported by the API
+	params := buildNetworkQueryParams(d, map[string]string{
+		"network_id": "network_id",
+		"name":       "name",
+		"tenant_id":  "tenant_id",
+		"cidr":       "cidr",
+	})
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	// Stream each subnet to the table
+	err := listNetworkCollection(ctx, d, "subnets", "subnets", params, func(item json.RawMessage) error {
+		var subnet NetworkSubnet
+		if err := json.Unmarshal(item, &subnet); err != nil {
+			return err
+		}
+		d.StreamListItem(ctx, subnet)
+		return nil
+	})
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve network subnets: %s", resp.Status)
-	}
+	return nil, err
+}
 
-	// Parse the response
-	var result struct {
-		Subnets []NetworkSubnet `json:"subnets"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+// getNetworkSubnet fetches a single subnet by its ID
+func getNetworkSubnet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	var subnet NetworkSubnet
+	found, err := getNetworkResource(ctx, d, "subnets/"+d.EqualsQualString("id"), "subnet", &subnet)
+	if err != nil || !found {
 		return nil, err
 	}
-
-	// Stream each subnet to the table
-	for _, subnet := range result.Subnets {
-		d.StreamListItem(ctx, subnet)
-	}
-
-	return nil, nil
+	return subnet, nil
 }
@@ -0,0 +1,308 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gophercloud/gophercloud/v2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// QuotaUsage represents the limit and usage of a single resource of a service
+type QuotaUsage struct {
+	Service     string
+	Resource    string
+	Limit       int
+	Used        *int
+	PercentUsed *float64
+}
+
+// newQuotaUsage builds a QuotaUsage, computing the percentage used when both
+// the usage and a positive limit are known.
+func newQuotaUsage(service, resource string, limit int, used *int) QuotaUsage {
+	usage := QuotaUsage{
+		Service:  service,
+		Resource: resource,
+		Limit:    limit,
+		Used:     used,
+	}
+	if used != nil && limit > 0 {
+		percentUsed := float64(*used) / float64(limit) * 100
+		usage.PercentUsed = &percentUsed
+	}
+	return usage
+}
+
+func tableRackspaceQuotaUsage() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_quota_usage",
+		Description: "Normalized limits and usage across Rackspace Compute, Load Balancers, DNS, Cloud Networks and Block Storage.",
+		List: &plugin.ListConfig{
+			Hydrate: listQuotaUsages,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "service", Type: proto.ColumnType_STRING, Description: "The service the quota belongs to, such as compute or loadbalancer."},
+			{Name: "resource", Type: proto.ColumnType_STRING, Description: "The resource the quota applies to, such as instances or volumes."},
+			{Name: "limit", Type: proto.ColumnType_INT, Description: "The maximum amount of the resource, -1 if unlimited.", Transform: transform.FromField("Limit")},
+			{Name: "used", Type: proto.ColumnType_INT, Description: "The amount of the resource in use, if the service reports it.", Transform: transform.FromField("Used")},
+			{Name: "percent_used", Type: proto.ColumnType_DOUBLE, Description: "The percentage of the limit in use.", Transform: transform.FromField("PercentUsed")},
+		}),
+	}
+}
+
+func listQuotaUsages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	services := []struct {
+		name      string
+		getUsages func(context.Context, *plugin.QueryData) ([]QuotaUsage, error)
+	}{
+		{"compute", getComputeQuotaUsages},
+		{"loadbalancer", getLoadBalancerQuotaUsages},
+		{"dns", getDNSQuotaUsages},
+		{"network", getNetworkQuotaUsages},
+		{"blockstorage", getVolumeQuotaUsages},
+	}
+
+	for _, service := range services {
+		usages, err := service.getUsages(ctx, d)
+		if err != nil {
+			// Skip services the account is not entitled to, instead of failing the whole table
+			if gophercloud.ResponseCodeIs(err, http.StatusForbidden) || gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				plugin.Logger(ctx).Warn("listQuotaUsages", "service", service.name, "skipped_error", err)
+				continue
+			}
+			return nil, err
+		}
+
+		// Stream each quota usage to the table
+		for _, usage := range usages {
+			d.StreamListItem(ctx, usage)
+		}
+	}
+
+	return nil, nil
+}
+
+// getComputeQuotaUsages returns the Compute absolute limits along with their usage
+func getComputeQuotaUsages(ctx context.Context, d *plugin.QueryData) ([]QuotaUsage, error) {
+	absolute, err := getComputeAbsoluteLimits(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []QuotaUsage{
+		newQuotaUsage("compute", "cores", absolute.MaxTotalCores, &absolute.TotalCoresUsed),
+		newQuotaUsage("compute", "instances", absolute.MaxTotalInstances, &absolute.TotalInstancesUsed),
+		newQuotaUsage("compute", "ram", absolute.MaxTotalRAMSize, &absolute.TotalRAMUsed),
+		newQuotaUsage("compute", "floating_ips", absolute.MaxTotalFloatingIps, &absolute.TotalFloatingIpsUsed),
+		newQuotaUsage("compute", "security_groups", absolute.MaxSecurityGroups, &absolute.TotalSecurityGroupsUsed),
+		newQuotaUsage("compute", "server_groups", absolute.MaxServerGroups, &absolute.TotalServerGroupsUsed),
+		newQuotaUsage("compute", "keypairs", absolute.MaxTotalKeypairs, nil),
+	}, nil
+}
+
+// getLoadBalancerQuotaUsages returns the load balancer absolute limits, only
+// the load balancer count is known.
+func getLoadBalancerQuotaUsages(ctx context.Context, d *plugin.QueryData) ([]QuotaUsage, error) {
+	limits, err := getLoadBalancerAbsoluteLimits(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []QuotaUsage
+	for _, limit := range limits {
+		var used *int
+		if limit.Name == "LOADBALANCER_LIMIT" {
+			if used, err = countLoadBalancers(ctx, d); err != nil {
+				return nil, err
+			}
+		}
+		usages = append(usages, newQuotaUsage("loadbalancer", limit.Name, limit.Value, used))
+	}
+
+	return usages, nil
+}
+
+// getDNSQuotaUsages returns the DNS absolute limits, only the domain count is known
+func getDNSQuotaUsages(ctx context.Context, d *plugin.QueryData) ([]QuotaUsage, error) {
+	limits, err := getDNSLimits(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []QuotaUsage
+	for _, limit := range limits {
+		if limit.Type != "absolute" {
+			continue
+		}
+		var used *int
+		if limit.Name == "domains" {
+			if used, err = countDNSDomains(ctx, d); err != nil {
+				return nil, err
+			}
+		}
+		usages = append(usages, newQuotaUsage("dns", limit.Name, limit.Value, used))
+	}
+
+	return usages, nil
+}
+
+// getVolumeQuotaUsages returns the Block Storage quotas along with their usage
+func getVolumeQuotaUsages(ctx context.Context, d *plugin.QueryData) ([]QuotaUsage, error) {
+	quotas, err := getVolumeQuotas(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []QuotaUsage
+	for _, quota := range quotas {
+		used := quota.InUse
+		usages = append(usages, newQuotaUsage("blockstorage", quota.Resource, quota.Limit, &used))
+	}
+
+	return usages, nil
+}
+
+// countLoadBalancers returns the number of load balancers of the tenant,
+// following the next links through every page.
+func countLoadBalancers(ctx context.Context, d *plugin.QueryData) (*int, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	nextPage := fmt.Sprintf(
+		"https://%s.loadbalancers.api.rackspacecloud.com/v1.0/%s/loadbalancers?limit=100",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+	)
+
+	// Continue paging through until no further pages are available
+	count := 0
+	for nextPage != "" {
+		var result struct {
+			LoadBalancers []json.RawMessage `json:"loadBalancers"`
+			Links         []Link            `json:"links"`
+		}
+		if err := getQuotaResource(ctx, d, nextPage, &result); err != nil {
+			return nil, fmt.Errorf("failed to retrieve load balancers: %w", err)
+		}
+		count += len(result.LoadBalancers)
+
+		currentPage := nextPage
+		nextPage = ""
+		for _, link := range result.Links {
+			if link.Rel == "next" && link.Href != currentPage {
+				nextPage = link.Href
+			}
+		}
+	}
+
+	return &count, nil
+}
+
+// countDNSDomains returns the number of DNS domains of the tenant
+func countDNSDomains(ctx context.Context, d *plugin.QueryData) (*int, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL, the total is reported regardless of the page size
+	apiUrl := fmt.Sprintf(
+		"https://dns.api.rackspacecloud.com/v1.0/%s/domains?limit=1",
+		*rackspaceConfig.TenantID,
+	)
+
+	var result struct {
+		TotalEntries int `json:"totalEntries"`
+	}
+	if err := getQuotaResource(ctx, d, apiUrl, &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve DNS domains: %w", err)
+	}
+
+	return &result.TotalEntries, nil
+}
+
+// getNetworkQuotaUsages returns the Cloud Networks quotas of the tenant along
+// with the number of resources in use.
+func getNetworkQuotaUsages(ctx context.Context, d *plugin.QueryData) ([]QuotaUsage, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://%s.networks.api.rackspacecloud.com/v2.0/quotas/%s",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+	)
+
+	var result struct {
+		Quota map[string]int `json:"quota"`
+	}
+	if err := getQuotaResource(ctx, d, apiUrl, &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve network quotas: %w", err)
+	}
+
+	// Map each quota to the collection counting its usage, as URL path and response key
+	collections := map[string][2]string{
+		"network":             {"networks", "networks"},
+		"subnet":              {"subnets", "subnets"},
+		"port":                {"ports", "ports"},
+		"security_group":      {"security-groups", "security_groups"},
+		"security_group_rule": {"security-group-rules", "security_group_rules"},
+	}
+
+	var usages []QuotaUsage
+	for resource, limit := range result.Quota {
+		var used *int
+		if collection, ok := collections[resource]; ok {
+			// Only the IDs are needed to count the resources in use
+			count := 0
+			params := url.Values{"fields": []string{"id"}}
+			err := walkNetworkCollection(ctx, d, collection[0], collection[1], params, networkPageSize, func(item json.RawMessage) (bool, error) {
+				count++
+				return true, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve %s: %w", collection[0], err)
+			}
+			used = &count
+		}
+		usages = append(usages, newQuotaUsage("network", resource, limit, used))
+	}
+
+	return usages, nil
+}
+
+// getQuotaResource performs an authenticated GET request and decodes the JSON response into result
+func getQuotaResource(ctx context.Context, d *plugin.QueryData, apiUrl string, result interface{}) error {
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp, "unexpected status")
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
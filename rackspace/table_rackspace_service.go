@@ -0,0 +1,108 @@
+package rackspace
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// serviceTablePrefixes maps the names of the service catalog to the prefixes
+// of the tables of this plugin covering them
+var serviceTablePrefixes = map[string][]string{
+	"cloudServersOpenStack": {"rackspace_compute_"},
+	"cloudFiles":            {"rackspace_cloud_files_"},
+	"cloudBlockStorage":     {"rackspace_volume", "rackspace_snapshot"},
+	"cloudImages":           {"rackspace_image"},
+	"cloudLoadBalancers":    {"rackspace_loadbalancer"},
+	"cloudDNS":              {"rackspace_dns_"},
+	"cloudNetworks":         {"rackspace_network"},
+	"cloudQueues":           {"rackspace_message_queue"},
+	"cloudBackup":           {"rackspace_backup_"},
+	"cloudMonitoring":       {"rackspace_monitoring_"},
+}
+
+// CatalogService represents a service of the service catalog and its coverage by the plugin
+type CatalogService struct {
+	Name     string
+	Type     string
+	Regions  []string
+	Versions []string
+	Tables   []string
+}
+
+func tableRackspaceService() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_service",
+		Description: "Services of the Rackspace service catalog and whether this plugin has tables for them.",
+		List: &plugin.ListConfig{
+			Hydrate: listCatalogServices,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the service, such as cloudServersOpenStack."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the service, such as compute."},
+			{Name: "regions", Type: proto.ColumnType_JSON, Description: "The regions the service is available in, empty for global services."},
+			{Name: "versions", Type: proto.ColumnType_JSON, Description: "The API versions of the service endpoints, if reported."},
+			{Name: "covered", Type: proto.ColumnType_BOOL, Description: "Whether this plugin has tables for the service.", Transform: transform.FromField("Tables").Transform(serviceCovered)},
+			{Name: "tables", Type: proto.ColumnType_JSON, Description: "The tables of this plugin covering the service."},
+		}),
+	}
+}
+
+func listCatalogServices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	access, err := getIdentityAccessMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range access.(*IdentityAccess).ServiceCatalog {
+		catalogService := CatalogService{
+			Name:     service.Name,
+			Type:     service.Type,
+			Regions:  []string{},
+			Versions: []string{},
+			Tables:   []string{},
+		}
+
+		// Collect the distinct regions and versions of the endpoints
+		regions := map[string]bool{}
+		versions := map[string]bool{}
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Region != "" && !regions[endpoint.Region] {
+				regions[endpoint.Region] = true
+				catalogService.Regions = append(catalogService.Regions, endpoint.Region)
+			}
+			if endpoint.VersionID != "" && !versions[endpoint.VersionID] {
+				versions[endpoint.VersionID] = true
+				catalogService.Versions = append(catalogService.Versions, endpoint.VersionID)
+			}
+		}
+		sort.Strings(catalogService.Regions)
+		sort.Strings(catalogService.Versions)
+
+		// Find the tables of the plugin covering the service
+		for tableName := range d.Table.Plugin.TableMap {
+			for _, prefix := range serviceTablePrefixes[service.Name] {
+				if strings.HasPrefix(tableName, prefix) {
+					catalogService.Tables = append(catalogService.Tables, tableName)
+					break
+				}
+			}
+		}
+		sort.Strings(catalogService.Tables)
+
+		d.StreamListItem(ctx, catalogService)
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func serviceCovered(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tables, _ := d.Value.([]string)
+	return len(tables) > 0, nil
+}
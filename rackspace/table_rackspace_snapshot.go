@@ -34,7 +34,7 @@ func tableRackspaceSnapshot() *plugin.Table {
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getSnapshot,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "snapshot", IDField: "ID", TitleField: "DisplayName", TagsField: "Metadata"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the snapshot"},
 			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The name of the snapshot"},
 			{Name: "volume_id", Type: proto.ColumnType_STRING, Description: "The ID of the volume from which the snapshot was created"},
@@ -43,14 +43,17 @@ func tableRackspaceSnapshot() *plugin.Table {
 			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp when the snapshot was created", Transform: transform.FromGo()},
 			{Name: "display_description", Type: proto.ColumnType_STRING, Description: "Description of the snapshot"},
 			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "Metadata associated with the snapshot"},
-		},
+		}),
 	}
 }
 
 // listSnapshots fetches all available snapshots from the Rackspace v1 Block Storage API
 func listSnapshots(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct API request URL
 	apiURL := fmt.Sprintf(
@@ -62,22 +65,19 @@ func listSnapshots(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	// Create HTTP request
 	req, err := http.NewRequest("GET", apiURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
 	// Perform the request
-	resp, err := httpClient.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %v", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Check the response status code
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, responseError(resp, "unexpected status code")
 	}
 
 	// Decode the response
@@ -85,7 +85,7 @@ func listSnapshots(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		Snapshots []Snapshot `json:"snapshots"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("error decoding response: %v", err)
+		return nil, fmt.Errorf("error decoding response: %w", err)
 	}
 
 	// Stream each snapshot
@@ -102,7 +102,10 @@ func getSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	snapshotID := d.EqualsQuals["id"].GetStringValue()
 
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct the API request URL with the snapshot ID
 	apiURL := fmt.Sprintf(
@@ -115,22 +118,19 @@ func getSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	// Create the HTTP request
 	req, err := http.NewRequest("GET", apiURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
 	// Perform the request
-	resp, err := httpClient.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %v", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Check the response status code
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, responseError(resp, "unexpected status code")
 	}
 
 	// Decode the response
@@ -138,7 +138,7 @@ func getSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 		Snapshot Snapshot `json:"snapshot"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("error decoding response: %v", err)
+		return nil, fmt.Errorf("error decoding response: %w", err)
 	}
 
 	// Return the snapshot data
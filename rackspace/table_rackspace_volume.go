@@ -51,7 +51,7 @@ func tableRackspaceVolume() *plugin.Table {
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getVolume,
 		},
-		Columns: []*plugin.Column{
+		Columns: resourceColumns(Resource{Type: "volume", IDField: "ID", TitleField: "DisplayName", TagsField: "Metadata"}, []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the volume"},
 			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The name of the volume"},
 			{Name: "status", Type: proto.ColumnType_STRING, Description: "The current status of the volume (e.g., 'available', 'in-use')."},
@@ -67,14 +67,18 @@ func tableRackspaceVolume() *plugin.Table {
 			{Name: "multiattach", Type: proto.ColumnType_STRING, Description: "Whether the volume supports multiple attachments (true/false)", Transform: transform.FromField("MultiAttach")},
 			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "Metadata associated with the volume"},
 			{Name: "attachments", Type: proto.ColumnType_JSON, Description: "The attached devices information for the volume"},
-		},
+			{Name: "estimated_monthly_cost", Type: proto.ColumnType_DOUBLE, Description: "The monthly cost of the volume estimated from its type and size, if a price catalog is configured", Hydrate: getVolumeEstimatedMonthlyCost, Transform: transform.FromValue()},
+		}),
 	}
 }
 
 // listVolumes fetches all available volumes from the Rackspace v1 Block Storage API
 func listVolumes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct API request URL
 	apiURL := fmt.Sprintf(
@@ -86,22 +90,19 @@ func listVolumes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	// Create HTTP request
 	req, err := http.NewRequest("GET", apiURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
 	// Perform the request
-	resp, err := httpClient.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %v", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Check the response status code
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, responseError(resp, "unexpected status code")
 	}
 
 	// Decode the response
@@ -109,7 +110,7 @@ func listVolumes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 		Volumes []Volume `json:"volumes"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("error decoding response: %v", err)
+		return nil, fmt.Errorf("error decoding response: %w", err)
 	}
 
 	// Stream each volume
@@ -126,7 +127,10 @@ func getVolume(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	volumeID := d.EqualsQuals["id"].GetStringValue()
 
 	// Get connection config
-	rackspaceConfig := GetConfig(d.Connection)
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct the API request URL with the volume ID
 	apiURL := fmt.Sprintf(
@@ -139,22 +143,19 @@ func getVolume(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	// Create the HTTP request
 	req, err := http.NewRequest("GET", apiURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Set the Authorization header
-	req.Header.Add("X-Auth-Token", *rackspaceConfig.TokenID)
-
 	// Perform the request
-	resp, err := httpClient.Do(req)
+	resp, err := doAuthenticatedRequest(ctx, d, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %v", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Check the response status code
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, responseError(resp, "unexpected status code")
 	}
 
 	// Decode the response
@@ -162,9 +163,23 @@ func getVolume(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		Volume Volume `json:"volume"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("error decoding response: %v", err)
+		return nil, fmt.Errorf("error decoding response: %w", err)
 	}
 
 	// Return the volume data
 	return result.Volume, nil
 }
+
+// getVolumeEstimatedMonthlyCost estimates the monthly cost of the volume from its type and size
+func getVolumeEstimatedMonthlyCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	volume := h.Item.(Volume)
+
+	return estimateMonthlyCost(ctx, d, h, func(catalog *PriceCatalog) *float64 {
+		pricePerGB, ok := catalog.VolumeTypes[volume.VolumeType]
+		if !ok {
+			return nil
+		}
+		cost := pricePerGB * float64(volume.Size)
+		return &cost
+	})
+}
@@ -0,0 +1,53 @@
+package rackspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// VolumeAttachment represents a single attachment of a volume to a server,
+// along with the volume details needed to report on it.
+type VolumeAttachment struct {
+	Attachment
+	VolumeName   string
+	VolumeStatus string
+}
+
+func tableRackspaceVolumeAttachment() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_volume_attachment",
+		Description: "Rackspace Block Storage Volume Attachments",
+		List: &plugin.ListConfig{
+			ParentHydrate: listVolumes,
+			Hydrate:       listVolumeAttachments,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the attachment"},
+			{Name: "attachment_id", Type: proto.ColumnType_STRING, Description: "The attachment ID reported by the Block Storage API", Transform: transform.FromField("AttachmentID")},
+			{Name: "volume_id", Type: proto.ColumnType_STRING, Description: "The ID of the attached volume", Transform: transform.FromField("VolumeID")},
+			{Name: "volume_name", Type: proto.ColumnType_STRING, Description: "The name of the attached volume"},
+			{Name: "volume_status", Type: proto.ColumnType_STRING, Description: "The current status of the attached volume"},
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The ID of the server the volume is attached to", Transform: transform.FromField("ServerID")},
+			{Name: "host_name", Type: proto.ColumnType_STRING, Description: "The name of the host the volume is attached to"},
+			{Name: "device", Type: proto.ColumnType_STRING, Description: "The device name of the attachment on the server (e.g., /dev/xvdb)"},
+		}),
+	}
+}
+
+// listVolumeAttachments streams one row per attachment of each volume
+func listVolumeAttachments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	volume := h.Item.(Volume)
+
+	for _, attachment := range volume.Attachments {
+		d.StreamListItem(ctx, VolumeAttachment{
+			Attachment:   attachment,
+			VolumeName:   volume.DisplayName,
+			VolumeStatus: volume.Status,
+		})
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,113 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// VolumeQuotaUsage represents the usage of a quota from the Rackspace Block Storage v1 API.
+type VolumeQuotaUsage struct {
+	InUse    int `json:"in_use"`
+	Limit    int `json:"limit"`
+	Reserved int `json:"reserved"`
+}
+
+// VolumeQuota represents a single Block Storage quota, such as volumes or gigabytes_SSD.
+type VolumeQuota struct {
+	VolumeQuotaUsage
+	Resource string
+}
+
+func tableRackspaceVolumeQuota() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_volume_quota",
+		Description: "Rackspace Block Storage Quotas",
+		List: &plugin.ListConfig{
+			Hydrate: listVolumeQuotas,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{Name: "resource", Type: proto.ColumnType_STRING, Description: "The quota resource (e.g., volumes, snapshots, gigabytes_SSD)"},
+			{Name: "limit", Type: proto.ColumnType_INT, Description: "The quota limit for the resource, -1 if unlimited", Transform: transform.FromField("Limit")},
+			{Name: "in_use", Type: proto.ColumnType_INT, Description: "The amount of the resource in use", Transform: transform.FromField("InUse")},
+			{Name: "reserved", Type: proto.ColumnType_INT, Description: "The amount of the resource reserved", Transform: transform.FromField("Reserved")},
+		}),
+	}
+}
+
+// listVolumeQuotas streams the quota usage of the tenant
+func listVolumeQuotas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quotas, err := getVolumeQuotas(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream each quota
+	for _, quota := range quotas {
+		d.StreamListItem(ctx, quota)
+	}
+
+	return nil, nil
+}
+
+// getVolumeQuotas fetches the quota usage of the tenant from the Rackspace v1 Block Storage API
+func getVolumeQuotas(ctx context.Context, d *plugin.QueryData) ([]VolumeQuota, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiURL := fmt.Sprintf(
+		"https://%s.blockstorage.api.rackspacecloud.com/v1/%s/os-quota-sets/%s?usage=true",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+		*rackspaceConfig.TenantID,
+	)
+
+	// Create HTTP request
+	req, err := http.NewRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Perform the request
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check the response status code
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "unexpected status code")
+	}
+
+	// Decode the response, the quota set also contains the tenant "id"
+	var result struct {
+		QuotaSet map[string]json.RawMessage `json:"quota_set"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	var quotas []VolumeQuota
+	for resource, raw := range result.QuotaSet {
+		var usage VolumeQuotaUsage
+		if err := json.Unmarshal(raw, &usage); err != nil {
+			continue
+		}
+		quotas = append(quotas, VolumeQuota{
+			VolumeQuotaUsage: usage,
+			Resource:         resource,
+		})
+	}
+
+	return quotas, nil
+}
@@ -0,0 +1,135 @@
+package rackspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// VolumeType represents a volume type from the Rackspace Block Storage v1 API.
+type VolumeType struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	ExtraSpecs map[string]string `json:"extra_specs"`
+}
+
+func tableRackspaceVolumeType() *plugin.Table {
+	return &plugin.Table{
+		Name:        "rackspace_volume_type",
+		Description: "Rackspace Block Storage Volume Types",
+		List: &plugin.ListConfig{
+			Hydrate: listVolumeTypes,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getVolumeType,
+		},
+		Columns: resourceColumns(Resource{Type: "volume-type", IDField: "ID", TitleField: "Name"}, []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the volume type"},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the volume type (e.g., SATA, SSD)"},
+			{Name: "extra_specs", Type: proto.ColumnType_JSON, Description: "Extra specifications associated with the volume type"},
+		}),
+	}
+}
+
+// listVolumeTypes fetches all volume types from the Rackspace v1 Block Storage API
+func listVolumeTypes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct API request URL
+	apiURL := fmt.Sprintf(
+		"https://%s.blockstorage.api.rackspacecloud.com/v1/%s/types",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+	)
+
+	// Create HTTP request
+	req, err := http.NewRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Perform the request
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check the response status code
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "unexpected status code")
+	}
+
+	// Decode the response
+	var result struct {
+		VolumeTypes []VolumeType `json:"volume_types"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	// Stream each volume type
+	for _, volumeType := range result.VolumeTypes {
+		d.StreamListItem(ctx, volumeType)
+	}
+
+	return nil, nil
+}
+
+// getVolumeType fetches a single volume type by its ID
+func getVolumeType(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the volume type ID from the query
+	volumeTypeID := d.EqualsQuals["id"].GetStringValue()
+
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the API request URL with the volume type ID
+	apiURL := fmt.Sprintf(
+		"https://%s.blockstorage.api.rackspacecloud.com/v1/%s/types/%s",
+		*rackspaceConfig.Region,
+		*rackspaceConfig.TenantID,
+		volumeTypeID,
+	)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Perform the request
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check the response status code
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, "unexpected status code")
+	}
+
+	// Decode the response
+	var result struct {
+		VolumeType VolumeType `json:"volume_type"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	// Return the volume type data
+	return result.VolumeType, nil
+}
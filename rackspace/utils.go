@@ -2,13 +2,17 @@ package rackspace
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v2/tokens"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
@@ -17,74 +21,355 @@ type TimeWrapper struct {
 	Time time.Time `json:"time"`
 }
 
+// tokenExpiryMargin is how long before the token expires the cached client is discarded
+const tokenExpiryMargin = 5 * time.Minute
+
+// defaultClientTTL is how long a client is cached when the token expiry is unknown
+const defaultClientTTL = 1 * time.Hour
+
 // Global httpClient for reuse
 var httpClient = &http.Client{
 	Timeout: 10 * time.Second,
 }
 
+// responseError returns an error for a response of a raw HTTP request that
+// was not successful. It wraps the status as gophercloud does, so errors of
+// raw HTTP and gophercloud tables can both be checked with ResponseCodeIs.
+func responseError(resp *http.Response, format string, args ...interface{}) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), gophercloud.ErrUnexpectedResponseCode{
+		URL:      resp.Request.URL.String(),
+		Method:   resp.Request.Method,
+		Expected: []int{http.StatusOK},
+		Actual:   resp.StatusCode,
+		Body:     body,
+	})
+}
+
+// shouldIgnoreErrors returns true for errors meaning the requested resource
+// does not exist, so that queries return no rows instead of failing. When
+// ignore_forbidden_errors is set, services the account is not entitled to
+// return no rows either.
+func shouldIgnoreErrors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData, err error) bool {
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return true
+	}
+
+	// Get connection config, an invalid one never ignores errors
+	rackspaceConfig, configErr := getValidatedConfig(d)
+	if configErr != nil {
+		return false
+	}
+	if gophercloud.ResponseCodeIs(err, http.StatusForbidden) && rackspaceConfig.IgnoreForbiddenErrors != nil && *rackspaceConfig.IgnoreForbiddenErrors {
+		plugin.Logger(ctx).Warn("shouldIgnoreErrors", "table", d.Table.Name, "ignored_error", err)
+		return true
+	}
+
+	return false
+}
+
+// rackspaceAuthOptions builds the Rackspace Identity v2.0 token request from
+// an API key, a password or an existing token.
+type rackspaceAuthOptions struct {
+	Username string
+	APIKey   string
+	Password string
+	TenantID string
+	TokenID  string
+}
+
+// ToTokenV2CreateMap implements tokens.AuthOptionsBuilder
+func (opts rackspaceAuthOptions) ToTokenV2CreateMap() (map[string]any, error) {
+	auth := map[string]any{}
+	switch {
+	case opts.APIKey != "":
+		auth["RAX-KSKEY:apiKeyCredentials"] = map[string]string{"username": opts.Username, "apiKey": opts.APIKey}
+	case opts.Password != "":
+		auth["passwordCredentials"] = map[string]string{"username": opts.Username, "password": opts.Password}
+	default:
+		auth["token"] = map[string]string{"id": opts.TokenID}
+		auth["tenantId"] = opts.TenantID
+	}
+	return map[string]any{"auth": auth}, nil
+}
+
+// CanReauth implements tokens.AuthOptionsBuilder. Only credentials can issue
+// a new token, re-posting an expired token fails.
+func (opts rackspaceAuthOptions) CanReauth() bool {
+	return opts.APIKey != "" || opts.Password != ""
+}
+
 func connect(ctx context.Context, d *plugin.QueryData) (*gophercloud.ProviderClient, error) {
+	// Validate the connection config before using any of its attributes
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	identityEndpoint := *rackspaceConfig.IdentityEndpoint
+	tenantID := *rackspaceConfig.TenantID
 
-	// Load connection from cache, which preserves throttling protection etc
-	cacheKey := "rackspace"
+	// Load connection from cache, which preserves throttling protection etc.
+	// Connections of different tenants or endpoints must not share a client.
+	cacheKey := fmt.Sprintf("rackspace-%s-%s", identityEndpoint, tenantID)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.(*gophercloud.ProviderClient), nil
 	}
 
-	// Start with an empty config
-	connectionOpts := gophercloud.AuthOptions{}
-	var identityEndpoint, tenantID, tokenID string
-
-	// Prefer config options given in Steampipe
-	rackspaceConfig := GetConfig(d.Connection)
-
-	if rackspaceConfig.IdentityEndpoint != nil {
-		identityEndpoint = *rackspaceConfig.IdentityEndpoint
+	// Prefer credentials, which allow re-authenticating once the token expired
+	authOpts := rackspaceAuthOptions{TenantID: tenantID}
+	if rackspaceConfig.Username != nil {
+		authOpts.Username = *rackspaceConfig.Username
+	}
+	if rackspaceConfig.APIKey != nil {
+		authOpts.APIKey = *rackspaceConfig.APIKey
 	}
-	if rackspaceConfig.TenantID != nil {
-		tenantID = *rackspaceConfig.TenantID
+	if rackspaceConfig.Password != nil {
+		authOpts.Password = *rackspaceConfig.Password
 	}
 	if rackspaceConfig.TokenID != nil {
-		tokenID = *rackspaceConfig.TokenID
+		authOpts.TokenID = *rackspaceConfig.TokenID
 	}
 
-	if identityEndpoint == "" {
-		return nil, errors.New("'identity_endpoint' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+	// Create the client, Rackspace only supports Identity v2.0
+	client, err := openstack.NewClient(identityEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Openstack client: %w", err)
 	}
-	connectionOpts.IdentityEndpoint = identityEndpoint
-	if tenantID == "" {
-		return nil, errors.New("'tenant_id' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+	err = openstack.AuthenticateV2(ctx, client, authOpts, gophercloud.EndpointOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error creating Openstack client: %w", err)
 	}
-	connectionOpts.TenantID = tenantID
 
-	if tokenID == "" {
-		return nil, errors.New("'token_id' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+	// Save to cache until shortly before the token expires
+	d.ConnectionManager.Cache.SetWithTTL(cacheKey, client, clientTTL(client))
+
+	// Done
+	return client, nil
+}
+
+// clientTTL returns how long the client can be cached, based on the expiry of its token
+func clientTTL(client *gophercloud.ProviderClient) time.Duration {
+	result, ok := client.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return defaultClientTTL
+	}
+	token, err := result.ExtractToken()
+	if err != nil {
+		return defaultClientTTL
 	}
-	connectionOpts.TokenID = tokenID
 
-	// Create the client
-	client, err := openstack.AuthenticatedClient(ctx, connectionOpts)
+	ttl := time.Until(token.ExpiresAt) - tokenExpiryMargin
+	if ttl <= 0 {
+		// The token is about to expire, rely on re-authentication
+		return tokenExpiryMargin
+	}
+	return ttl
+}
 
+// doAuthenticatedRequest sends a raw HTTP request with the token of the
+// cached client. When the token was rejected, it re-authenticates once and
+// retries, provided the connection is configured with credentials.
+func doAuthenticatedRequest(ctx context.Context, d *plugin.QueryData, req *http.Request) (*http.Response, error) {
+	provider, err := connect(ctx, d)
 	if err != nil {
-		return nil, fmt.Errorf("error creating Openstack client: %s", err.Error())
+		return nil, err
 	}
 
-	// Save to cache
-	d.ConnectionManager.Cache.Set(cacheKey, client)
+	token := provider.Token()
+	resp, err := sendWithToken(ctx, req, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || provider.ReauthFunc == nil {
+		return resp, err
+	}
+	resp.Body.Close()
 
-	// Done
-	return client, nil
+	// Skipped if another request already re-authenticated the client
+	if err := provider.Reauthenticate(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to re-authenticate: %w", err)
+	}
+	return sendWithToken(ctx, req, provider.Token())
+}
+
+// sendWithToken sends a copy of req with the given token as the Authorization header
+func sendWithToken(ctx context.Context, req *http.Request, token string) (*http.Response, error) {
+	req = req.Clone(ctx)
+	req.Header.Set("X-Auth-Token", token)
+	return httpClient.Do(req)
 }
 
 func getRegion(_ context.Context, d *plugin.QueryData) (*string, error) {
-	// Prefer config options given in Steampipe
-	rackspaceConfig := GetConfig(d.Connection)
-	var region *string
-	if rackspaceConfig.Region != nil {
-		region = rackspaceConfig.Region
+	// Validate the connection config, which guarantees the region is set
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	return rackspaceConfig.Region, nil
+}
+
+// networkPageSize is the number of items requested per Cloud Networks page
+const networkPageSize = 100
+
+// Link represents a pagination link of a Cloud Networks collection
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+// buildNetworkQueryParams converts the equality quals of the given columns
+// into Neutron query parameters, keyed by column name.
+func buildNetworkQueryParams(d *plugin.QueryData, columns map[string]string) url.Values {
+	params := url.Values{}
+	for column, param := range columns {
+		if value := d.EqualsQualString(column); value != "" {
+			params.Set(param, value)
+		}
+	}
+	return params
+}
+
+// listNetworkCollection pages through a Cloud Networks collection such as
+// ports using limit and marker, following the next link of {key}_links, and
+// calls streamItem for each item. It stops early once the SQL LIMIT of the
+// query is satisfied.
+func listNetworkCollection(ctx context.Context, d *plugin.QueryData, path string, key string, params url.Values, streamItem func(item json.RawMessage) error) error {
+	// Request no more items per page than the query needs
+	pageSize := networkPageSize
+	if limit := d.QueryContext.Limit; limit != nil && *limit < int64(pageSize) {
+		pageSize = int(*limit)
+	}
+
+	return walkNetworkCollection(ctx, d, path, key, params, pageSize, func(item json.RawMessage) (bool, error) {
+		if err := streamItem(item); err != nil {
+			return false, err
+		}
+
+		// Stop if the context is cancelled or the limit has been hit
+		return d.RowsRemaining(ctx) != 0, nil
+	})
+}
+
+// walkNetworkCollection pages through a Cloud Networks collection with the
+// given page size and calls visit for each item until it returns false.
+func walkNetworkCollection(ctx context.Context, d *plugin.QueryData, path string, key string, params url.Values, pageSize int, visit func(item json.RawMessage) (bool, error)) error {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return err
+	}
+	params.Set("limit", strconv.Itoa(pageSize))
+
+	// Construct API request URL
+	nextPage := fmt.Sprintf(
+		"https://%s.networks.api.rackspacecloud.com/v2.0/%s?%s",
+		*rackspaceConfig.Region,
+		path,
+		params.Encode(),
+	)
+
+	// Continue paging through until no further pages are available
+	for nextPage != "" {
+		items, next, err := getNetworkPage(ctx, d, nextPage, key)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			more, err := visit(item)
+			if err != nil || !more {
+				return err
+			}
+		}
+		nextPage = next
+	}
+
+	return nil
+}
+
+// getNetworkPage fetches a single page of a Cloud Networks collection and
+// returns its items along with the URL of the next page, if any.
+func getNetworkPage(ctx context.Context, d *plugin.QueryData, pageUrl string, key string) ([]json.RawMessage, string, error) {
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", pageUrl, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", responseError(resp, "failed to retrieve %s", key)
+	}
+
+	// Parse the response
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, "", err
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(body[key], &items); err != nil {
+		return nil, "", err
+	}
+
+	// Check if there is a next page link
+	var links []Link
+	if raw, ok := body[key+"_links"]; ok {
+		if err := json.Unmarshal(raw, &links); err != nil {
+			return nil, "", err
+		}
+	}
+	for _, link := range links {
+		if link.Rel == "next" {
+			return items, link.Href, nil
+		}
+	}
+
+	return items, "", nil
+}
+
+// getNetworkResource fetches a single Cloud Networks resource such as
+// networks/{id} and decodes the object under key into result. It returns
+// false when the resource does not exist.
+func getNetworkResource(ctx context.Context, d *plugin.QueryData, path string, key string, result interface{}) (bool, error) {
+	// Get connection config
+	rackspaceConfig, err := getValidatedConfig(d)
+	if err != nil {
+		return false, err
+	}
+
+	// Construct API request URL
+	apiUrl := fmt.Sprintf(
+		"https://%s.networks.api.rackspacecloud.com/v2.0/%s",
+		*rackspaceConfig.Region,
+		path,
+	)
+
+	// Create an HTTP request
+	req, err := http.NewRequest("GET", apiUrl, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := doAuthenticatedRequest(ctx, d, req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, responseError(resp, "failed to retrieve %s", path)
 	}
 
-	if *region == "" {
-		return nil, errors.New("'region' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+	// Parse the response
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return false, err
 	}
-	return region, nil
+	return true, json.Unmarshal(body[key], result)
 }